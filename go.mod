@@ -2,11 +2,13 @@ module github.com/WhiCu/gmath
 
 go 1.25.0
 
-require golang.org/x/exp v0.0.0-20250819193227-8b4c13bb791b
+require (
+	github.com/smartystreets/goconvey v1.8.1
+	golang.org/x/exp v0.0.0-20250819193227-8b4c13bb791b
+)
 
 require (
 	github.com/gopherjs/gopherjs v1.17.2 // indirect
 	github.com/jtolds/gls v4.20.0+incompatible // indirect
 	github.com/smarty/assertions v1.16.0 // indirect
-	github.com/smartystreets/goconvey v1.8.1 // indirect
 )
@@ -0,0 +1,323 @@
+package tensor
+
+// BandMatrix is an N x N matrix whose nonzeros lie within KL diagonals below
+// and KU diagonals above the main one. Row i stores the columns i-KL..i+KU,
+// so element (i, j) lives at Data[i*(KL+KU+1) + j-i+KL]. Slots that fall
+// outside the matrix are kept zero.
+type BandMatrix[T Number] struct {
+	N, KL, KU int
+	Data      []T
+}
+
+func NewBandMatrix[T Number](n, kl, ku int) (*BandMatrix[T], error) {
+	if n < 0 || kl < 0 || ku < 0 {
+		return nil, ErrInvalidShape
+	}
+	return &BandMatrix[T]{N: n, KL: kl, KU: ku, Data: make([]T, n*(kl+ku+1))}, nil
+}
+
+// BandMatrixFrom stores a square m with the smallest bandwidths holding all of
+// its nonzeros.
+func BandMatrixFrom[T Number](m *Matrix[T]) (*BandMatrix[T], error) {
+	n := m.Shape[0]
+	if m.Shape[1] != n {
+		return nil, ErrNotSquare
+	}
+	kl, ku := 0, 0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if m.MustAt(i, j) != 0 {
+				kl, ku = max(kl, i-j), max(ku, j-i)
+			}
+		}
+	}
+	b, err := NewBandMatrix[T](n, kl, ku)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+			b.Data[b.index(i, j)] = m.MustAt(i, j)
+		}
+	}
+	return b, nil
+}
+
+func (b *BandMatrix[T]) index(i, j int) int {
+	return i*(b.KL+b.KU+1) + j - i + b.KL
+}
+
+func (b *BandMatrix[T]) inBand(i, j int) bool {
+	return j-i <= b.KU && i-j <= b.KL
+}
+
+func (b *BandMatrix[T]) At(i, j int) (T, error) {
+	var zero T
+	if i < 0 || j < 0 || i >= b.N || j >= b.N {
+		return zero, ErrIndexOutOfRange
+	}
+	if !b.inBand(i, j) {
+		return zero, nil
+	}
+	return b.Data[b.index(i, j)], nil
+}
+
+func (b *BandMatrix[T]) MustAt(i, j int) T {
+	v, err := b.At(i, j)
+	Must(err)
+	return v
+}
+
+// Set stores v at (i, j). Only zeros may be stored outside the band.
+func (b *BandMatrix[T]) Set(v T, i, j int) error {
+	if i < 0 || j < 0 || i >= b.N || j >= b.N {
+		return ErrIndexOutOfRange
+	}
+	if !b.inBand(i, j) {
+		if v != 0 {
+			return ErrIndexOutOfRange
+		}
+		return nil
+	}
+	b.Data[b.index(i, j)] = v
+	return nil
+}
+
+func (b *BandMatrix[T]) MustSet(v T, i, j int) {
+	err := b.Set(v, i, j)
+	Must(err)
+}
+
+func (b *BandMatrix[T]) Matrix() *Matrix[T] {
+	out := NewMatrix[T](b.N, b.N)
+	for i := 0; i < b.N; i++ {
+		for j := max(0, i-b.KL); j <= min(b.N-1, i+b.KU); j++ {
+			out.Data[i*b.N+j] = b.Data[b.index(i, j)]
+		}
+	}
+	return out
+}
+
+func (b *BandMatrix[T]) Dims() (rows, cols int) {
+	return b.N, b.N
+}
+
+// Apply returns b·x in O(N·(KL+KU)).
+func (b *BandMatrix[T]) Apply(x *Vector[T]) (*Vector[T], error) {
+	if len(x.Shape) != 1 || x.Shape[0] != b.N {
+		return nil, ErrShapeMismatch
+	}
+	xs := x.Contiguous().contiguousData()
+	out := NewVector[T](b.N)
+	for i := 0; i < b.N; i++ {
+		var s T
+		for j := max(0, i-b.KL); j <= min(b.N-1, i+b.KU); j++ {
+			s += b.Data[b.index(i, j)] * xs[j]
+		}
+		out.Data[i] = s
+	}
+	return out, nil
+}
+
+// Solve returns x with b·x = rhs. Diagonally dominant tridiagonal matrices
+// use the Thomas algorithm, everything else a banded LU.
+func (b *BandMatrix[T]) Solve(rhs *Vector[T]) (*Vector[T], error) {
+	if b.KL == 1 && b.KU == 1 && b.diagonallyDominant() {
+		lower, diag, upper := NewVector[T](b.N), NewVector[T](b.N), NewVector[T](b.N)
+		for i := 0; i < b.N; i++ {
+			lower.Data[i] = b.Data[b.index(i, i)-1]
+			diag.Data[i] = b.Data[b.index(i, i)]
+			upper.Data[i] = b.Data[b.index(i, i)+1]
+		}
+		return SolveTridiagonal(lower, diag, upper, rhs)
+	}
+	f, err := NewBandLU(b)
+	if err != nil {
+		return nil, err
+	}
+	return f.Solve(rhs)
+}
+
+func (b *BandMatrix[T]) diagonallyDominant() bool {
+	for i := 0; i < b.N; i++ {
+		off := 0.0
+		for j := max(0, i-b.KL); j <= min(b.N-1, i+b.KU); j++ {
+			if j != i {
+				off += modulus(b.Data[b.index(i, j)])
+			}
+		}
+		if off > modulus(b.Data[b.index(i, i)]) {
+			return false
+		}
+	}
+	return true
+}
+
+// SolveTridiagonal solves the tridiagonal system with sub-diagonal lower[1:],
+// diagonal diag and super-diagonal upper[:n-1] by the Thomas algorithm in
+// O(n). It does not pivot, so it is only stable for diagonally dominant or
+// Hermitian positive definite matrices; use BandMatrix.Solve otherwise.
+func SolveTridiagonal[T Number](lower, diag, upper, b *Vector[T]) (out *Vector[T], err error) {
+	const op = "SolveTridiagonal"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if isInteger[T]() {
+		return nil, ErrNotImplemented
+	}
+	n := diag.Shape[0]
+	if lower.Shape[0] != n || upper.Shape[0] != n || b.Shape[0] != n {
+		return nil, ErrShapeMismatch
+	}
+
+	c := make([]T, n) // modified super-diagonal
+	x := NewVector[T](n)
+	for i := 0; i < n; i++ {
+		d := diag.MustAt(i)
+		rhs := b.MustAt(i)
+		if i > 0 {
+			l := lower.MustAt(i)
+			d -= l * c[i-1]
+			rhs -= l * x.Data[i-1]
+		}
+		if d == 0 {
+			return nil, ErrSingularMatrix
+		}
+		if i < n-1 {
+			c[i] = upper.MustAt(i) / d
+		}
+		x.Data[i] = rhs / d
+	}
+	for i := n - 2; i >= 0; i-- {
+		x.Data[i] -= c[i] * x.Data[i+1]
+	}
+	return x, nil
+}
+
+// BandLU is the banded factorization P·A = L·U with partial pivoting. U has
+// bandwidth KL+KU, L keeps KL multipliers per column, and factoring costs
+// O(N·KL·(KL+KU)).
+type BandLU[T Number] struct {
+	n, kl, ku int // ku is the bandwidth of U
+	u         []T // row i stores the columns i-kl..i+ku
+	l         []T // l[k*kl + i-k-1] is the multiplier of row i at step k
+	piv       []int
+	sign      int
+}
+
+// NewBandLU factors b. Like NewLU it factors singular matrices as well and
+// returns ErrNotImplemented for integer types.
+func NewBandLU[T Number](b *BandMatrix[T]) (out *BandLU[T], err error) {
+	const op = "BandLU"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if isInteger[T]() {
+		return nil, ErrNotImplemented
+	}
+	n, kl := b.N, b.KL
+	f := &BandLU[T]{
+		n:    n,
+		kl:   kl,
+		ku:   kl + b.KU,
+		l:    make([]T, n*kl),
+		piv:  make([]int, n),
+		sign: 1,
+	}
+	w := kl + f.ku + 1
+	f.u = make([]T, n*w)
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j <= min(n-1, i+b.KU); j++ {
+			f.u[f.index(i, j)] = b.Data[b.index(i, j)]
+		}
+	}
+
+	u := f.u
+	for k := 0; k < n; k++ {
+		last := min(n-1, k+kl)
+		right := min(n-1, k+f.ku)
+		p := k
+		for i := k + 1; i <= last; i++ {
+			if modulus(u[f.index(i, k)]) > modulus(u[f.index(p, k)]) {
+				p = i
+			}
+		}
+		f.piv[k] = p
+		if p != k {
+			for j := k; j <= right; j++ {
+				u[f.index(k, j)], u[f.index(p, j)] = u[f.index(p, j)], u[f.index(k, j)]
+			}
+			f.sign = -f.sign
+		}
+
+		pivot := u[f.index(k, k)]
+		if pivot == 0 {
+			continue
+		}
+		for i := k + 1; i <= last; i++ {
+			m := u[f.index(i, k)] / pivot
+			u[f.index(i, k)] = 0
+			if m == 0 {
+				continue
+			}
+			f.l[k*kl+i-k-1] = m
+			for j := k + 1; j <= right; j++ {
+				u[f.index(i, j)] -= m * u[f.index(k, j)]
+			}
+		}
+	}
+	return f, nil
+}
+
+func (f *BandLU[T]) index(i, j int) int {
+	return i*(f.kl+f.ku+1) + j - i + f.kl
+}
+
+func (f *BandLU[T]) Det() T {
+	det := T(1)
+	if f.sign < 0 {
+		det = 0 - det
+	}
+	for i := 0; i < f.n; i++ {
+		det *= f.u[f.index(i, i)]
+	}
+	return det
+}
+
+// Solve returns x with A·x = b.
+func (f *BandLU[T]) Solve(b *Vector[T]) (out *Vector[T], err error) {
+	const op = "BandLU.Solve"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if len(b.Shape) != 1 || b.Shape[0] != f.n {
+		return nil, ErrShapeMismatch
+	}
+	n := f.n
+	for i := 0; i < n; i++ {
+		if f.u[f.index(i, i)] == 0 {
+			return nil, ErrSingularMatrix
+		}
+	}
+
+	x := NewVector[T](n)
+	copy(x.Data, b.Contiguous().contiguousData())
+	y := x.Data
+	for k := 0; k < n; k++ {
+		y[k], y[f.piv[k]] = y[f.piv[k]], y[k]
+		for i := k + 1; i <= min(n-1, k+f.kl); i++ {
+			y[i] -= f.l[k*f.kl+i-k-1] * y[k]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		s := y[i]
+		for j := i + 1; j <= min(n-1, i+f.ku); j++ {
+			s -= f.u[f.index(i, j)] * y[j]
+		}
+		y[i] = s / f.u[f.index(i, i)]
+	}
+	return x, nil
+}
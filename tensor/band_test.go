@@ -0,0 +1,116 @@
+package tensor
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBandMatrix(t *testing.T) {
+	Convey("Given a banded matrix with KL=2 and KU=1", t, func() {
+		const n = 8
+		dense := NewMatrix[float64](n, n)
+		for i := 0; i < n; i++ {
+			for j := max(0, i-2); j <= min(n-1, i+1); j++ {
+				dense.MustSet(float64((i*7+j*3)%5)-2, i, j)
+			}
+		}
+		// нулевой первый элемент диагонали требует перестановки строк
+		dense.MustSet(0, 0, 0)
+		b, err := BandMatrixFrom(dense)
+		So(err, ShouldBeNil)
+		So(b.KL, ShouldEqual, 2)
+		So(b.KU, ShouldEqual, 1)
+		So(len(b.Data), ShouldEqual, n*4)
+
+		Convey("It round-trips through Matrix", func() {
+			So(b.Matrix().Data, ShouldResemble, dense.Data)
+			So(b.MustAt(0, 5), ShouldEqual, 0)
+			So(b.Set(1, 0, 5), ShouldNotBeNil)
+			So(b.Set(0, 0, 5), ShouldBeNil)
+			_, err := b.At(n, 0)
+			So(errors.Is(err, ErrIndexOutOfRange), ShouldBeTrue)
+		})
+
+		Convey("Apply matches the dense product", func() {
+			x := NewVector[float64](n)
+			for i := range x.Data {
+				x.Data[i] = float64(i) - 3.5
+			}
+			got, err := b.Apply(x)
+			So(err, ShouldBeNil)
+			want, _ := dense.Apply(x)
+			So(got.Data, ShouldResemble, want.Data)
+		})
+
+		Convey("The banded LU agrees with the dense LU", func() {
+			f, err := NewBandLU(b)
+			So(err, ShouldBeNil)
+			lu, err := NewLU(dense)
+			So(err, ShouldBeNil)
+			So(f.Det(), ShouldAlmostEqual, lu.Det(), 1e-9)
+
+			rhs := NewVector[float64](n)
+			for i := range rhs.Data {
+				rhs.Data[i] = float64(i%3) + 1
+			}
+			got, err := b.Solve(rhs)
+			So(err, ShouldBeNil)
+			want, err := lu.Solve(rhs)
+			So(err, ShouldBeNil)
+			expectClose(t, got.Data, want.Data, 1e-9)
+		})
+	})
+
+	Convey("Given a tridiagonal system", t, func() {
+		const n = 100
+		lower, diag, upper := NewVector[float64](n), NewVector[float64](n), NewVector[float64](n)
+		rhs := NewVector[float64](n)
+		for i := 0; i < n; i++ {
+			lower.Data[i], diag.Data[i], upper.Data[i] = -1, 2.5, -1
+			rhs.Data[i] = math.Sin(float64(i))
+		}
+
+		Convey("Thomas and the banded LU give the same answer", func() {
+			x, err := SolveTridiagonal(lower, diag, upper, rhs)
+			So(err, ShouldBeNil)
+
+			b, _ := NewBandMatrix[float64](n, 1, 1)
+			for i := 0; i < n; i++ {
+				b.MustSet(diag.Data[i], i, i)
+				if i > 0 {
+					b.MustSet(-1, i, i-1)
+					b.MustSet(-1, i-1, i)
+				}
+			}
+			f, err := NewBandLU(b)
+			So(err, ShouldBeNil)
+			y, err := f.Solve(rhs)
+			So(err, ShouldBeNil)
+			expectClose(t, x.Data, y.Data, 1e-12)
+
+			ax, _ := b.Apply(x)
+			expectClose(t, ax.Data, rhs.Data, 1e-12)
+		})
+
+		Convey("A zero pivot is reported", func() {
+			diag.Data[0] = 0
+			_, err := SolveTridiagonal(lower, diag, upper, rhs)
+			So(errors.Is(err, ErrSingularMatrix), ShouldBeTrue)
+		})
+	})
+
+	Convey("Singular and integer band matrices are rejected", t, func() {
+		b, _ := NewBandMatrix[float64](3, 1, 0)
+		b.MustSet(1, 0, 0)
+		b.MustSet(1, 1, 0)
+		b.MustSet(1, 2, 2)
+		_, err := b.Solve(NewVector[float64](3))
+		So(errors.Is(err, ErrSingularMatrix), ShouldBeTrue)
+
+		_, err = NewBandLU(&BandMatrix[int]{N: 1, Data: []int{1}})
+		So(errors.Is(err, ErrNotImplemented), ShouldBeTrue)
+	})
+}
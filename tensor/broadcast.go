@@ -0,0 +1,96 @@
+package tensor
+
+import "slices"
+
+// BroadcastShapes returns the shape all given shapes broadcast to. Shapes are
+// aligned on their trailing dimensions; a dimension of size 1 is stretched to
+// match the other, any other mismatch is ErrShapeMismatch.
+func BroadcastShapes(shapes ...[]int) ([]int, error) {
+	n := 0
+	for _, s := range shapes {
+		n = max(n, len(s))
+	}
+	out := make([]int, n)
+	for i := range out {
+		out[i] = 1
+	}
+	for _, s := range shapes {
+		shift := n - len(s)
+		for i, d := range s {
+			switch {
+			case d == out[shift+i] || d == 1:
+			case out[shift+i] == 1:
+				out[shift+i] = d
+			default:
+				return nil, ErrShapeMismatch
+			}
+		}
+	}
+	return out, nil
+}
+
+// BroadcastTo returns a read-mostly view of t with the given shape. Stretched
+// axes get a zero stride, so writing through the view changes every element
+// that maps to the same source value.
+func BroadcastTo[T Number](t *Tensor[T], shape ...int) (out *Tensor[T], err error) {
+	const op = "BroadcastTo"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if len(shape) < len(t.Shape) {
+		return nil, ErrShapeMismatch
+	}
+	shift := len(shape) - len(t.Shape)
+	strides := make([]int, len(shape))
+	size := 1
+	for i, d := range shape {
+		size *= d
+		if i < shift {
+			continue
+		}
+		switch src := t.Shape[i-shift]; {
+		case src == d:
+			strides[i] = t.Strides[i-shift]
+		case src == 1:
+			strides[i] = 0
+		default:
+			return nil, ErrShapeMismatch
+		}
+	}
+	return &Tensor[T]{
+		Shape:   slices.Clone(shape),
+		size:    size,
+		Strides: strides,
+		Offset:  t.Offset,
+		Data:    t.Data,
+	}, nil
+}
+
+func (t *Tensor[T]) BroadcastTo(shape ...int) (*Tensor[T], error) {
+	return BroadcastTo(t, shape...)
+}
+
+func (t *Tensor[T]) MustBroadcastTo(shape ...int) *Tensor[T] {
+	out, err := BroadcastTo(t, shape...)
+	Must(err)
+	return out
+}
+
+// broadcastPair stretches a and b to their common shape.
+func broadcastPair[T Number](a, b *Tensor[T]) (*Tensor[T], *Tensor[T], error) {
+	if SameShape(a, b) {
+		return a, b, nil
+	}
+	shape, err := BroadcastShapes(a.Shape, b.Shape)
+	if err != nil {
+		return nil, nil, err
+	}
+	if a, err = BroadcastTo(a, shape...); err != nil {
+		return nil, nil, err
+	}
+	if b, err = BroadcastTo(b, shape...); err != nil {
+		return nil, nil, err
+	}
+	return a, b, nil
+}
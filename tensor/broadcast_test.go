@@ -0,0 +1,88 @@
+package tensor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBroadcasting(t *testing.T) {
+	Convey("BroadcastShapes aligns trailing dimensions", t, func() {
+		s, err := BroadcastShapes([]int{2, 3}, []int{3})
+		So(err, ShouldBeNil)
+		So(s, ShouldResemble, []int{2, 3})
+
+		s, err = BroadcastShapes([]int{4, 1, 3}, []int{2, 1}, []int{})
+		So(err, ShouldBeNil)
+		So(s, ShouldResemble, []int{4, 2, 3})
+
+		s, err = BroadcastShapes([]int{0, 3}, []int{1, 3})
+		So(err, ShouldBeNil)
+		So(s, ShouldResemble, []int{0, 3})
+
+		_, err = BroadcastShapes([]int{2, 3}, []int{2})
+		So(err, ShouldEqual, ErrShapeMismatch)
+	})
+
+	Convey("Given a 2x3 matrix and a bias vector", t, func() {
+		a := NewTensor[int](2, 3)
+		for i := range a.Data {
+			a.Data[i] = i + 1
+		}
+		bias := NewTensor[int](3)
+		bias.Data = []int{10, 20, 30}
+
+		Convey("BroadcastTo returns a zero-stride view", func() {
+			v, err := bias.BroadcastTo(2, 3)
+			So(err, ShouldBeNil)
+			So(v.Strides, ShouldResemble, []int{0, 1})
+			So(v.Copy().Data, ShouldResemble, []int{10, 20, 30, 10, 20, 30})
+
+			_, err = bias.BroadcastTo(3, 2)
+			So(errors.Is(err, ErrShapeMismatch), ShouldBeTrue)
+		})
+
+		Convey("Add broadcasts the bias over every row", func() {
+			out, err := Add(a, bias)
+			So(err, ShouldBeNil)
+			So(out.Shape, ShouldResemble, []int{2, 3})
+			So(out.Data, ShouldResemble, []int{11, 22, 33, 14, 25, 36})
+		})
+
+		Convey("Column vectors and row vectors broadcast to an outer product shape", func() {
+			col := NewTensor[int](2, 1)
+			col.Data = []int{1, 2}
+			out, err := ElemMul(col, bias)
+			So(err, ShouldBeNil)
+			So(out.Data, ShouldResemble, []int{10, 20, 30, 20, 40, 60})
+
+			out, err = Sub(bias, col)
+			So(err, ShouldBeNil)
+			So(out.Data, ShouldResemble, []int{9, 19, 29, 8, 18, 28})
+		})
+
+		Convey("Channel-wise scaling of a 3-D tensor", func() {
+			x := NewTensor[float64](2, 2, 2)
+			for i := range x.Data {
+				x.Data[i] = 12
+			}
+			ch := NewTensor[float64](2, 1, 1)
+			ch.Data = []float64{2, 3}
+			out, err := Div(x, ch)
+			So(err, ShouldBeNil)
+			So(out.Data, ShouldResemble, []float64{6, 6, 6, 6, 4, 4, 4, 4})
+		})
+
+		Convey("In-place methods broadcast the argument only", func() {
+			So(a.Add(bias), ShouldBeNil)
+			So(a.Data, ShouldResemble, []int{11, 22, 33, 14, 25, 36})
+			So(bias.Add(a), ShouldEqual, ErrShapeMismatch)
+		})
+
+		Convey("Incompatible shapes still fail", func() {
+			_, err := Add(a, NewTensor[int](2))
+			So(errors.Is(err, ErrShapeMismatch), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,119 @@
+package tensor
+
+import "math"
+
+// CholeskyFactor holds the lower triangular L with A = L·Lᴴ.
+type CholeskyFactor[T Number] struct {
+	n int
+	l []T // row-major n x n, zero above the diagonal
+}
+
+// Cholesky factors a symmetric (Hermitian for complex T) positive-definite
+// matrix. Only the lower triangle of m is read. Matrices that are not
+// positive-definite return ErrNotPositiveDefinite.
+func Cholesky[T Number](m *Matrix[T]) (out *CholeskyFactor[T], err error) {
+	const op = "Cholesky"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if isInteger[T]() {
+		return nil, ErrNotImplemented
+	}
+	n := m.Shape[0]
+	if m.Shape[1] != n {
+		return nil, ErrNotSquare
+	}
+
+	a := m.Copy().Data
+	l := make([]T, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			s := a[i*n+j]
+			for k := 0; k < j; k++ {
+				s -= l[i*n+k] * conj(l[j*n+k])
+			}
+			if i != j {
+				l[i*n+j] = s / l[j*n+j]
+				continue
+			}
+			d := toFloat(s)
+			if !(d > 0) {
+				return nil, ErrNotPositiveDefinite
+			}
+			l[i*n+i] = fromFloat[T](math.Sqrt(d))
+		}
+	}
+	return &CholeskyFactor[T]{n: n, l: l}, nil
+}
+
+func (c *CholeskyFactor[T]) L() *Matrix[T] {
+	out := NewMatrix[T](c.n, c.n)
+	copy(out.Data, c.l)
+	return out
+}
+
+// Solve returns x with A·x = b.
+func (c *CholeskyFactor[T]) Solve(b *Vector[T]) (out *Vector[T], err error) {
+	const op = "Cholesky.Solve"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if len(b.Shape) != 1 || b.Shape[0] != c.n {
+		return nil, ErrShapeMismatch
+	}
+	x := b.Copy()
+	c.solveInPlace(x.Data, 1)
+	return &Vector[T]{x}, nil
+}
+
+// solveInPlace solves L·Lᴴ·X = B for the row-major n x k block x.
+func (c *CholeskyFactor[T]) solveInPlace(x []T, k int) {
+	n, l := c.n, c.l
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			for col := 0; col < k; col++ {
+				x[i*k+col] -= l[i*n+j] * x[j*k+col]
+			}
+		}
+		for col := 0; col < k; col++ {
+			x[i*k+col] /= l[i*n+i]
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			lji := conj(l[j*n+i])
+			for col := 0; col < k; col++ {
+				x[i*k+col] -= lji * x[j*k+col]
+			}
+		}
+		for col := 0; col < k; col++ {
+			x[i*k+col] /= l[i*n+i]
+		}
+	}
+}
+
+func (c *CholeskyFactor[T]) Det() T {
+	det := T(1)
+	for i := 0; i < c.n; i++ {
+		d := c.l[i*c.n+i]
+		det *= d * d
+	}
+	return det
+}
+
+// LogDet returns log(det A), which does not overflow for large matrices.
+func (c *CholeskyFactor[T]) LogDet() float64 {
+	s := 0.0
+	for i := 0; i < c.n; i++ {
+		s += math.Log(toFloat(c.l[i*c.n+i]))
+	}
+	return 2 * s
+}
+
+func (c *CholeskyFactor[T]) Inverse() *Matrix[T] {
+	out := Identity[T](c.n)
+	c.solveInPlace(out.Data, c.n)
+	return out
+}
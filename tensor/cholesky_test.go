@@ -0,0 +1,65 @@
+package tensor
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCholesky(t *testing.T) {
+	Convey("Given an SPD matrix", t, func() {
+		a := NewMatrix[float64](3, 3)
+		a.Data = []float64{
+			4, 12, -16,
+			12, 37, -43,
+			-16, -43, 98,
+		}
+		c, err := Cholesky(a)
+		So(err, ShouldBeNil)
+
+		Convey("L is the known factor", func() {
+			expectClose(t, c.L().Data, []float64{2, 0, 0, 6, 1, 0, -8, 5, 3}, 1e-12)
+		})
+
+		Convey("Det, LogDet and Inverse", func() {
+			So(c.Det(), ShouldAlmostEqual, 36, 1e-9)
+			So(c.LogDet(), ShouldAlmostEqual, math.Log(36), 1e-12)
+			id, err := MatMul(a, c.Inverse())
+			So(err, ShouldBeNil)
+			expectClose(t, id.Data, Identity[float64](3).Data, 1e-9)
+		})
+
+		Convey("Solve", func() {
+			b := NewVector[float64](3)
+			b.Data = []float64{4 + 12 - 16, 12 + 37 - 43, -16 - 43 + 98}
+			x, err := c.Solve(b)
+			So(err, ShouldBeNil)
+			expectClose(t, x.Data, []float64{1, 1, 1}, 1e-9)
+		})
+	})
+
+	Convey("Hermitian complex input", t, func() {
+		a := NewMatrix[complex128](2, 2)
+		a.Data = []complex128{4, 2 - 1i, 2 + 1i, 3}
+		c, err := Cholesky(a)
+		So(err, ShouldBeNil)
+		llh, err := MatMul(c.L(), ConjTranspose(c.L()))
+		So(err, ShouldBeNil)
+		for i := range a.Data {
+			So(cmplxDist(llh.Data[i], a.Data[i]), ShouldBeLessThan, 1e-12)
+		}
+		So(cmplxDist(c.Det(), 7), ShouldBeLessThan, 1e-12)
+	})
+
+	Convey("Non positive-definite input", t, func() {
+		a := NewMatrix[float64](2, 2)
+		a.Data = []float64{1, 2, 2, 1}
+		_, err := Cholesky(a)
+		So(errors.Is(err, ErrNotPositiveDefinite), ShouldBeTrue)
+
+		_, err = Cholesky(NewMatrix[float64](2, 3))
+		So(errors.Is(err, ErrNotSquare), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,99 @@
+package tensor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDetInverseTrace(t *testing.T) {
+	Convey("Integer matrices use exact arithmetic", t, func() {
+		a := NewMatrix[int](3, 3)
+		a.Data = []int{
+			0, 2, 1,
+			1, 1, 1,
+			2, 1, 3,
+		}
+		det, err := a.Det()
+		So(err, ShouldBeNil)
+		So(det, ShouldEqual, -3)
+
+		tr, err := a.Trace()
+		So(err, ShouldBeNil)
+		So(tr, ShouldEqual, 4)
+
+		_, err = a.Inverse()
+		So(errors.Is(err, ErrNotIntegral), ShouldBeTrue)
+
+		u := NewMatrix[int](3, 3)
+		u.Data = []int{
+			2, 3, 1,
+			1, 2, 1,
+			0, 0, 1,
+		}
+		inv, err := u.Inverse()
+		So(err, ShouldBeNil)
+		id, err := MatMul(u, inv)
+		So(err, ShouldBeNil)
+		So(id.Data, ShouldResemble, Identity[int](3).Data)
+
+		wide := NewMatrix[int64](2, 2)
+		wide.Data = []int64{1 << 30, 3, 5, 1 << 30}
+		det64, err := wide.Det()
+		So(err, ShouldBeNil)
+		So(det64, ShouldEqual, int64(1)<<60-15)
+
+		small := NewMatrix[int8](2, 2)
+		small.Data = []int8{100, 0, 0, 100}
+		_, err = small.Det()
+		So(errors.Is(err, ErrOverflow), ShouldBeTrue)
+	})
+
+	Convey("Row swaps flip the sign", t, func() {
+		a := NewMatrix[int](2, 2)
+		a.Data = []int{0, 1, 1, 0}
+		det, err := a.Det()
+		So(err, ShouldBeNil)
+		So(det, ShouldEqual, -1)
+
+		f := NewMatrix[float64](2, 2)
+		f.Data = []float64{0, 1, 1, 0}
+		fdet, err := f.Det()
+		So(err, ShouldBeNil)
+		So(fdet, ShouldEqual, -1)
+	})
+
+	Convey("Float inverse and singular matrices", t, func() {
+		a := NewMatrix[float64](2, 2)
+		a.Data = []float64{4, 7, 2, 6}
+		inv, err := a.Inverse()
+		So(err, ShouldBeNil)
+		expectClose(t, inv.Data, []float64{0.6, -0.7, -0.2, 0.4}, 1e-12)
+
+		// Тот же масштаб ниже GetEpsilon: обусловленность не меняется
+		tiny := &Matrix[float64]{Scale(a.Tensor, 1e-13)}
+		inv, err = tiny.Inverse()
+		So(err, ShouldBeNil)
+		expectClose(t, inv.Data, []float64{0.6e13, -0.7e13, -0.2e13, 0.4e13}, 1e-1)
+		id := &Matrix[float64]{Scale(Identity[float64](3).Tensor, 1e-13)}
+		inv, err = id.Inverse()
+		So(err, ShouldBeNil)
+		So(inv.MustAt(2, 2), ShouldAlmostEqual, 1e13, 1)
+
+		s := NewMatrix[float64](2, 2)
+		s.Data = []float64{1, 2, 2, 4}
+		_, err = s.Inverse()
+		So(errors.Is(err, ErrSingularMatrix), ShouldBeTrue)
+
+		si := NewMatrix[int](2, 2)
+		si.Data = []int{1, 2, 2, 4}
+		_, err = si.Inverse()
+		So(errors.Is(err, ErrSingularMatrix), ShouldBeTrue)
+
+		_, err = NewMatrix[int](2, 3).Det()
+		So(errors.Is(err, ErrNotSquare), ShouldBeTrue)
+		_, err = NewMatrix[int](2, 3).Trace()
+		So(err, ShouldEqual, ErrNotSquare)
+	})
+}
@@ -0,0 +1,147 @@
+package tensor
+
+import "math/big"
+
+// Echelon is a matrix in row-echelon form together with how it was obtained.
+type Echelon[T Number] struct {
+	*Matrix[T]
+	// Pivots lists the pivot column of every non-zero row, in row order.
+	// Columns not listed correspond to free variables.
+	Pivots []int
+	// Perm is the row permutation: row i was row Perm[i] of the input before
+	// elimination.
+	Perm []int
+}
+
+// RowEchelon reduces m to row-echelon form with partial pivoting. Entries
+// within the working tolerance of zero do not become pivots. Integer matrices
+// are reduced fraction-free, so every row keeps integer entries and the rank
+// is exact; each row is then divided by the gcd of its entries.
+func RowEchelon[T Number](m *Matrix[T]) *Echelon[T] {
+	rows, cols := m.Shape[0], m.Shape[1]
+	e := &Echelon[T]{
+		Matrix: &Matrix[T]{m.Copy()},
+		Perm:   make([]int, rows),
+	}
+	for i := range e.Perm {
+		e.Perm[i] = i
+	}
+
+	a := e.Data
+	tol := echelonTolerance(a)
+	r := 0
+	for c := 0; c < cols && r < rows; c++ {
+		p := -1
+		for i := r; i < rows; i++ {
+			v := modulus(a[i*cols+c])
+			if v <= tol {
+				a[i*cols+c] = 0
+				continue
+			}
+			if p == -1 || (!isInteger[T]() && v > modulus(a[p*cols+c])) {
+				p = i
+			}
+		}
+		if p == -1 {
+			continue
+		}
+		e.MustSwapRows(r, p)
+		e.Perm[r], e.Perm[p] = e.Perm[p], e.Perm[r]
+
+		pivot := a[r*cols+c]
+		for i := r + 1; i < rows; i++ {
+			v := a[i*cols+c]
+			if v == 0 {
+				continue
+			}
+			if isInteger[T]() {
+				for j := c; j < cols; j++ {
+					a[i*cols+j] = pivot*a[i*cols+j] - v*a[r*cols+j]
+				}
+				reduceRow(a[i*cols : (i+1)*cols])
+				continue
+			}
+			factor := v / pivot
+			for j := c + 1; j < cols; j++ {
+				a[i*cols+j] -= factor * a[r*cols+j]
+			}
+			a[i*cols+c] = 0
+		}
+		e.Pivots = append(e.Pivots, c)
+		r++
+	}
+	return e
+}
+
+// RREF reduces m to reduced row-echelon form: every pivot is 1 and is the only
+// non-zero entry of its column. Integer types return ErrNotImplemented, since
+// the result generally is not integral; use RatMatrixFrom and RatMatrix.RREF.
+func RREF[T Number](m *Matrix[T]) (out *Echelon[T], err error) {
+	const op = "RREF"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if isInteger[T]() {
+		return nil, ErrNotImplemented
+	}
+
+	e := RowEchelon(m)
+	cols := e.Shape[1]
+	a := e.Data
+	for r := len(e.Pivots) - 1; r >= 0; r-- {
+		c := e.Pivots[r]
+		pivot := a[r*cols+c]
+		for j := c; j < cols; j++ {
+			a[r*cols+j] /= pivot
+		}
+		a[r*cols+c] = 1
+		for i := 0; i < r; i++ {
+			factor := a[i*cols+c]
+			if factor == 0 {
+				continue
+			}
+			for j := c; j < cols; j++ {
+				a[i*cols+j] -= factor * a[r*cols+j]
+			}
+			a[i*cols+c] = 0
+		}
+	}
+	return e, nil
+}
+
+// Rank returns the rank of m, computed from its row-echelon form.
+func Rank[T Number](m *Matrix[T]) int {
+	return len(RowEchelon(m).Pivots)
+}
+
+// echelonTolerance is the modulus below which entries count as zero: zero for
+// integers, otherwise GetEpsilon scaled by the largest entry.
+func echelonTolerance[T Number](a []T) float64 {
+	if isInteger[T]() {
+		return 0
+	}
+	largest := 0.0
+	for _, v := range a {
+		largest = max(largest, modulus(v))
+	}
+	return epsilon[T]() * largest
+}
+
+// reduceRow divides an integer row by the gcd of its entries.
+func reduceRow[T Number](row []T) {
+	g := new(big.Int)
+	for _, v := range row {
+		g.GCD(nil, nil, g, new(big.Int).Abs(toBigInt(v)))
+	}
+	if g.Sign() == 0 || g.IsInt64() && g.Int64() == 1 {
+		return
+	}
+	d, err := fromBigInt[T](g)
+	if err != nil {
+		return
+	}
+	for i := range row {
+		row[i] /= d
+	}
+}
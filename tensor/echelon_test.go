@@ -0,0 +1,89 @@
+package tensor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEchelonForms(t *testing.T) {
+	Convey("Given a matrix with a column without pivot", t, func() {
+		a := NewMatrix[float64](3, 4)
+		a.Data = []float64{
+			1, 2, 1, 1,
+			2, 4, 0, 4,
+			1, 2, 2, 0,
+		}
+
+		Convey("RowEchelon reports pivots and permutation", func() {
+			e := RowEchelon(a)
+			So(e.Pivots, ShouldResemble, []int{0, 2})
+			So(e.Perm, ShouldResemble, []int{1, 2, 0})
+			for j := 0; j < 4; j++ {
+				So(e.MustAt(2, j), ShouldAlmostEqual, 0, 1e-12)
+			}
+		})
+
+		Convey("RREF normalizes pivots and clears their columns", func() {
+			e, err := RREF(a)
+			So(err, ShouldBeNil)
+			So(e.Pivots, ShouldResemble, []int{0, 2})
+			expectClose(t, e.Data, []float64{
+				1, 2, 0, 2,
+				0, 0, 1, -1,
+				0, 0, 0, 0,
+			}, 1e-12)
+		})
+
+		Convey("Rank is correct where RankOfMatrix is not", func() {
+			So(Rank(a), ShouldEqual, 2)
+			So(RankOfMatrix(a), ShouldEqual, 3)
+		})
+	})
+
+	Convey("Integer matrices stay exact", t, func() {
+		a := NewMatrix[int](3, 3)
+		a.Data = []int{
+			2, 4, 6,
+			3, 5, 7,
+			5, 9, 13,
+		}
+		e := RowEchelon(a)
+		So(e.Pivots, ShouldResemble, []int{0, 1})
+		So(Rank(a), ShouldEqual, 2)
+		for j := 0; j < 3; j++ {
+			So(e.MustAt(2, j), ShouldEqual, 0)
+		}
+
+		_, err := RREF(a)
+		So(errors.Is(err, ErrNotImplemented), ShouldBeTrue)
+	})
+
+	Convey("SolveGauss detects singular consistent systems", t, func() {
+		a := NewMatrix[float64](2, 2)
+		a.Data = []float64{1, 2, 2, 4}
+		b := NewVector[float64](2)
+		b.Data = []float64{3, 6}
+		_, err := SolveGauss(a, b)
+		So(errors.Is(err, ErrInfinitelyMany), ShouldBeTrue)
+
+		b.Data = []float64{3, 7}
+		_, err = SolveGauss(a, b)
+		So(errors.Is(err, ErrNoSolution), ShouldBeTrue)
+	})
+
+	Convey("The tolerance scales with the entries", t, func() {
+		a := &Matrix[float64]{Scale(Identity[float64](3).Tensor, 1e-13)}
+		So(Rank(a), ShouldEqual, 3)
+		b := NewVector[float64](3)
+		b.Data = []float64{1e-13, 2e-13, 3e-13}
+		x, err := SolveGauss(a, b)
+		So(err, ShouldBeNil)
+		expectClose(t, x.Data, []float64{1, 2, 3}, 1e-12)
+
+		a.MustSet(1e-30, 2, 2)
+		So(Rank(a), ShouldEqual, 2)
+		So(Rank(NewMatrix[float64](2, 2)), ShouldEqual, 0)
+	})
+}
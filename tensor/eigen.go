@@ -0,0 +1,310 @@
+package tensor
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+const maxQRIterations = 30
+
+// EigSym returns the eigenvalues of a symmetric (Hermitian for complex T)
+// matrix in ascending order together with orthonormal eigenvectors as the
+// columns of the second result. It uses cyclic Jacobi rotations.
+func EigSym[T Number](m *Matrix[T]) (values []float64, vectors *Matrix[T], err error) {
+	const op = "EigSym"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if isInteger[T]() {
+		return nil, nil, ErrNotImplemented
+	}
+	n := m.Shape[0]
+	if m.Shape[1] != n {
+		return nil, nil, ErrNotSquare
+	}
+
+	a := &Matrix[T]{m.Copy()}
+	frob := norm2(a.Data)
+	tol := machineEpsilon[T]() * float64(n) * frob
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			if modulus(a.Data[i*n+j]-conj(a.Data[j*n+i])) > tol*16 {
+				return nil, nil, ErrNotHermitian
+			}
+		}
+	}
+
+	v := Identity[T](n)
+	converged := false
+	for sweep := 0; sweep < maxJacobiSweeps && !converged; sweep++ {
+		off := 0.0
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				off = math.Hypot(off, modulus(a.Data[p*n+q]))
+			}
+		}
+		if off <= tol {
+			converged = true
+			break
+		}
+		for p := 0; p < n-1; p++ {
+			for q := p + 1; q < n; q++ {
+				apq := a.Data[p*n+q]
+				g := modulus(apq)
+				if g == 0 {
+					continue
+				}
+				app, aqq := toFloat(a.Data[p*n+p]), toFloat(a.Data[q*n+q])
+				theta := (aqq - app) / (2 * g)
+				t := math.Copysign(1, theta) / (math.Abs(theta) + math.Sqrt(1+theta*theta))
+				c := 1 / math.Sqrt(1+t*t)
+				ct, st := fromFloat[T](c), fromFloat[T](c*t)
+				e := phase(apq)
+				// J = D·P: D убирает фазу a_pq, P — обычное вещественное вращение Якоби
+				rotateColumns(a, p, q, ct, st, conj(e))
+				rotateRows(a, p, q, ct, st, e)
+				rotateColumns(v, p, q, ct, st, conj(e))
+				a.Data[p*n+q], a.Data[q*n+p] = 0, 0
+			}
+		}
+	}
+	if !converged {
+		return nil, nil, ErrNoConvergence
+	}
+
+	order := make([]int, n)
+	diag := make([]float64, n)
+	for i := range order {
+		order[i] = i
+		diag[i] = toFloat(a.Data[i*n+i])
+	}
+	sort.SliceStable(order, func(i, j int) bool { return diag[order[i]] < diag[order[j]] })
+
+	values = make([]float64, n)
+	vectors = NewMatrix[T](n, n)
+	for j, src := range order {
+		values[j] = diag[src]
+		for i := 0; i < n; i++ {
+			vectors.Data[i*n+j] = v.Data[i*n+src]
+		}
+	}
+	return values, vectors, nil
+}
+
+// rotateRows sets (x, y) = (c·x - s·e·y, s·x + c·e·y) for rows i and j.
+func rotateRows[T Number](m *Matrix[T], i, j int, c, s, e T) {
+	cols := m.Shape[1]
+	for k := 0; k < cols; k++ {
+		x, y := m.Data[i*cols+k], e*m.Data[j*cols+k]
+		m.Data[i*cols+k] = c*x - s*y
+		m.Data[j*cols+k] = s*x + c*y
+	}
+}
+
+// Eig returns the eigenvalues and unit eigenvectors (as columns) of a general
+// square matrix. The matrix is reduced to Hessenberg form and then to Schur
+// form by shifted QR iterations; eigenvectors come from back substitution on
+// the Schur form. Results are complex even for real input.
+func Eig[T Number](m *Matrix[T]) (values []complex128, vectors *Matrix[complex128], err error) {
+	const op = "Eig"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	n := m.Shape[0]
+	if m.Shape[1] != n {
+		return nil, nil, ErrNotSquare
+	}
+
+	h := NewMatrix[complex128](n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			h.Data[i*n+j] = toComplex(m.MustAt(i, j))
+		}
+	}
+	z := Identity[complex128](n)
+	hessenberg(h, z)
+	if err := schur(h, z); err != nil {
+		return nil, nil, err
+	}
+
+	values = make([]complex128, n)
+	for i := range values {
+		values[i] = h.Data[i*n+i]
+	}
+	return values, schurVectors(h, z), nil
+}
+
+// hessenberg reduces h to upper Hessenberg form with Householder reflectors,
+// accumulating them into z.
+func hessenberg(h, z *Matrix[complex128]) {
+	n := h.Shape[0]
+	for k := 0; k < n-2; k++ {
+		v := make([]complex128, n-k-1)
+		for i := range v {
+			v[i] = h.Data[(k+1+i)*n+k]
+		}
+		norm := norm2(v)
+		if norm == 0 {
+			continue
+		}
+		v[0] += phase(v[0]) * complex(norm, 0)
+		vnorm := complex(norm2(v), 0)
+		for i := range v {
+			v[i] /= vnorm
+		}
+
+		// h = P·h·P, z = z·P, P = I - 2·v·vᴴ on indices k+1..n-1
+		for j := 0; j < n; j++ {
+			var s complex128
+			for i, vi := range v {
+				s += cmplx.Conj(vi) * h.Data[(k+1+i)*n+j]
+			}
+			for i, vi := range v {
+				h.Data[(k+1+i)*n+j] -= 2 * vi * s
+			}
+		}
+		for _, mat := range []*Matrix[complex128]{h, z} {
+			for r := 0; r < n; r++ {
+				var s complex128
+				for i, vi := range v {
+					s += mat.Data[r*n+k+1+i] * vi
+				}
+				for i, vi := range v {
+					mat.Data[r*n+k+1+i] -= 2 * s * cmplx.Conj(vi)
+				}
+			}
+		}
+		for i := k + 2; i < n; i++ {
+			h.Data[i*n+k] = 0
+		}
+	}
+}
+
+// schur reduces the Hessenberg matrix h to upper triangular form with
+// single-shift QR steps (Wilkinson shift), accumulating rotations into z.
+func schur(h, z *Matrix[complex128]) error {
+	n := h.Shape[0]
+	eps := machineEpsilon[complex128]()
+	at := func(i, j int) complex128 { return h.Data[i*n+j] }
+
+	hi, iter := n-1, 0
+	for hi > 0 {
+		l := hi
+		for ; l > 0; l-- {
+			if cmplx.Abs(at(l, l-1)) <= eps*(cmplx.Abs(at(l-1, l-1))+cmplx.Abs(at(l, l))) {
+				h.Data[l*n+l-1] = 0
+				break
+			}
+		}
+		if l == hi {
+			hi--
+			iter = 0
+			continue
+		}
+		iter++
+		if iter > maxQRIterations*n {
+			return ErrNoConvergence
+		}
+
+		var mu complex128
+		if iter%10 == 0 {
+			// Исключительный сдвиг против зацикливания
+			mu = at(hi, hi) + complex(cmplx.Abs(at(hi, hi-1)), 0)
+		} else {
+			a, b, c, d := at(hi-1, hi-1), at(hi-1, hi), at(hi, hi-1), at(hi, hi)
+			half := (a - d) / 2
+			root := cmplx.Sqrt(half*half + b*c)
+			mu1, mu2 := (a+d)/2+root, (a+d)/2-root
+			mu = mu1
+			if cmplx.Abs(mu2-d) < cmplx.Abs(mu1-d) {
+				mu = mu2
+			}
+		}
+
+		for k := l; k <= hi; k++ {
+			h.Data[k*n+k] -= mu
+		}
+		cs := make([]float64, hi-l)
+		sn := make([]complex128, hi-l)
+		for k := l; k < hi; k++ {
+			c, s := givens(at(k, k), at(k+1, k))
+			cs[k-l], sn[k-l] = c, s
+			for j := k; j < n; j++ {
+				x, y := at(k, j), at(k+1, j)
+				h.Data[k*n+j] = complex(c, 0)*x + s*y
+				h.Data[(k+1)*n+j] = -cmplx.Conj(s)*x + complex(c, 0)*y
+			}
+		}
+		for k := l; k < hi; k++ {
+			c, s := complex(cs[k-l], 0), sn[k-l]
+			for r := 0; r <= min(k+2, hi); r++ {
+				x, y := at(r, k), at(r, k+1)
+				h.Data[r*n+k] = x*c + y*cmplx.Conj(s)
+				h.Data[r*n+k+1] = -s*x + c*y
+			}
+			for r := 0; r < n; r++ {
+				x, y := z.Data[r*n+k], z.Data[r*n+k+1]
+				z.Data[r*n+k] = x*c + y*cmplx.Conj(s)
+				z.Data[r*n+k+1] = -s*x + c*y
+			}
+		}
+		for k := l; k <= hi; k++ {
+			h.Data[k*n+k] += mu
+		}
+	}
+	return nil
+}
+
+// givens returns c, s with [c s; -s̄ c]·[a; b] = [r; 0].
+func givens(a, b complex128) (float64, complex128) {
+	if b == 0 {
+		return 1, 0
+	}
+	if a == 0 {
+		return 0, 1
+	}
+	ra := cmplx.Abs(a)
+	r := math.Hypot(ra, cmplx.Abs(b))
+	return ra / r, (a / complex(ra, 0)) * cmplx.Conj(b) / complex(r, 0)
+}
+
+// schurVectors returns z·y, where the columns of y are the eigenvectors of the
+// upper triangular t, normalized to unit length.
+func schurVectors(t, z *Matrix[complex128]) *Matrix[complex128] {
+	n := t.Shape[0]
+	norm := norm2(t.Data)
+	small := machineEpsilon[complex128]() * math.Max(norm, 1)
+
+	out := NewMatrix[complex128](n, n)
+	y := make([]complex128, n)
+	for k := 0; k < n; k++ {
+		lambda := t.Data[k*n+k]
+		clear(y)
+		y[k] = 1
+		for i := k - 1; i >= 0; i-- {
+			var s complex128
+			for j := i + 1; j <= k; j++ {
+				s += t.Data[i*n+j] * y[j]
+			}
+			d := t.Data[i*n+i] - lambda
+			if cmplx.Abs(d) < small {
+				d = complex(small, 0)
+			}
+			y[i] = -s / d
+		}
+		col := make([]complex128, n)
+		for r := 0; r < n; r++ {
+			for j := 0; j <= k; j++ {
+				col[r] += z.Data[r*n+j] * y[j]
+			}
+		}
+		scale := complex(norm2(col), 0)
+		for r := 0; r < n; r++ {
+			out.Data[r*n+k] = col[r] / scale
+		}
+	}
+	return out
+}
@@ -0,0 +1,132 @@
+package tensor
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEigSym(t *testing.T) {
+	Convey("Given a real symmetric matrix", t, func() {
+		a := NewMatrix[float64](3, 3)
+		a.Data = []float64{
+			2, -1, 0,
+			-1, 2, -1,
+			0, -1, 2,
+		}
+		values, vectors, err := EigSym(a)
+		So(err, ShouldBeNil)
+
+		Convey("Eigenvalues are sorted and exact", func() {
+			expectClose(t, values, []float64{2 - math.Sqrt2, 2, 2 + math.Sqrt2}, 1e-12)
+		})
+
+		Convey("Eigenvectors are orthonormal and satisfy A·v = λ·v", func() {
+			vtv, err := MatMul(ConjTranspose(vectors), vectors)
+			So(err, ShouldBeNil)
+			expectClose(t, vtv.Data, Identity[float64](3).Data, 1e-12)
+
+			av, err := MatMul(a, vectors)
+			So(err, ShouldBeNil)
+			for j, l := range values {
+				for i := 0; i < 3; i++ {
+					So(av.MustAt(i, j), ShouldAlmostEqual, l*vectors.MustAt(i, j), 1e-12)
+				}
+			}
+		})
+	})
+
+	Convey("Hermitian complex input", t, func() {
+		a := NewMatrix[complex128](2, 2)
+		a.Data = []complex128{2, 1 - 1i, 1 + 1i, 3}
+		values, vectors, err := EigSym(a)
+		So(err, ShouldBeNil)
+		expectClose(t, values, []float64{1, 4}, 1e-12)
+		av, err := MatMul(a, vectors)
+		So(err, ShouldBeNil)
+		for j, l := range values {
+			for i := 0; i < 2; i++ {
+				So(cmplxDist(av.MustAt(i, j), complex(l, 0)*vectors.MustAt(i, j)), ShouldBeLessThan, 1e-12)
+			}
+		}
+	})
+
+	Convey("Non-symmetric input is rejected", t, func() {
+		a := NewMatrix[float64](2, 2)
+		a.Data = []float64{1, 2, 3, 4}
+		_, _, err := EigSym(a)
+		So(errors.Is(err, ErrNotHermitian), ShouldBeTrue)
+	})
+}
+
+func TestEig(t *testing.T) {
+	checkPairs := func(a *Matrix[complex128], values []complex128, vectors *Matrix[complex128]) {
+		n := a.Shape[0]
+		av, err := MatMul(a, vectors)
+		So(err, ShouldBeNil)
+		for j, l := range values {
+			for i := 0; i < n; i++ {
+				So(cmplxDist(av.MustAt(i, j), l*vectors.MustAt(i, j)), ShouldBeLessThan, 1e-10)
+			}
+		}
+	}
+	toComplexMatrix := func(a *Matrix[float64]) *Matrix[complex128] {
+		out := NewMatrix[complex128](a.Shape[0], a.Shape[1])
+		for i, v := range a.Data {
+			out.Data[i] = complex(v, 0)
+		}
+		return out
+	}
+
+	Convey("A real rotation has a complex conjugate pair", t, func() {
+		a := NewMatrix[float64](2, 2)
+		a.Data = []float64{0, -1, 1, 0}
+		values, vectors, err := Eig(a)
+		So(err, ShouldBeNil)
+		checkPairs(toComplexMatrix(a), values, vectors)
+
+		sort.Slice(values, func(i, j int) bool { return imag(values[i]) < imag(values[j]) })
+		So(cmplxDist(values[0], -1i), ShouldBeLessThan, 1e-12)
+		So(cmplxDist(values[1], 1i), ShouldBeLessThan, 1e-12)
+	})
+
+	Convey("A general real matrix", t, func() {
+		a := NewMatrix[float64](4, 4)
+		a.Data = []float64{
+			4, 7, -2, 2,
+			1, 2, 0, 1,
+			-2, 0, 3, -2,
+			2, 1, -2, -1,
+		}
+		values, vectors, err := Eig(a)
+		So(err, ShouldBeNil)
+		checkPairs(toComplexMatrix(a), values, vectors)
+
+		var trace complex128
+		for _, v := range values {
+			trace += v
+		}
+		So(cmplx.Abs(trace-8), ShouldBeLessThan, 1e-10)
+	})
+
+	Convey("Complex and integer input", t, func() {
+		c := NewMatrix[complex128](3, 3)
+		c.Data = []complex128{1i, 2, 0, 1, 1 - 1i, 3, 2i, 0, 1}
+		values, vectors, err := Eig(c)
+		So(err, ShouldBeNil)
+		checkPairs(c, values, vectors)
+
+		d := NewMatrix[int](2, 2)
+		d.Data = []int{2, 0, 0, 3}
+		values, _, err = Eig(d)
+		So(err, ShouldBeNil)
+		So(len(values), ShouldEqual, 2)
+
+		_, _, err = Eig(NewMatrix[float64](2, 3))
+		So(errors.Is(err, ErrNotSquare), ShouldBeTrue)
+	})
+}
@@ -9,10 +9,15 @@ var (
 	// Индексация
 	ErrWrongNumberOfIndices = errors.New("wrong number of indices")
 	ErrIndexOutOfRange      = errors.New("index out of range")
+	ErrInvalidRange         = errors.New("invalid range")
 
 	// Операции с тензорами
 	ErrShapeMismatch = errors.New("shape mismatch")
 	ErrSizeMismatch  = errors.New("size mismatch")
+	ErrInvalidShape  = errors.New("invalid shape")
+
+	// Редукции
+	ErrEmptyReduction = errors.New("reduction over an empty axis")
 
 	// Транспонирование
 	ErrInvalidTransposeOrder = errors.New("order must have the same length as shape")
@@ -23,13 +28,26 @@ var (
 	ErrSingularMatrix = errors.New("singular matrix")
 	ErrNoSolution     = errors.New("no solution")
 	ErrInfinitelyMany = errors.New("infinitely many solutions")
+	ErrNotSquare      = errors.New("matrix is not square")
+	ErrNotIntegral    = errors.New("result is not integral")
+	ErrOverflow       = errors.New("result overflows the element type")
+	ErrNotFinite      = errors.New("value is not finite")
+	ErrInvalidModulus = errors.New("modulus must be a prime below 2^31")
+
+	// Разложения
+	ErrNotPositiveDefinite = errors.New("matrix is not positive definite")
+	ErrNoConvergence       = errors.New("iteration did not converge")
+	ErrInvalidRelaxation   = errors.New("relaxation factor must lie in (0, 2)")
+	ErrNotHermitian        = errors.New("matrix is not symmetric or hermitian")
+	ErrReleaseView         = errors.New("cannot release a view")
+	ErrDoubleRelease       = errors.New("tensor is already held by the pool")
 
 	// DEV
 	ErrNotImplemented = errors.New("not implemented")
 )
 
 func Wrap(err error, msg string) error {
-	return fmt.Errorf("%s: %v", msg, err)
+	return fmt.Errorf("%s: %w", msg, err)
 }
 
 func WrapIfNil(err error, msg string) error {
@@ -0,0 +1,546 @@
+package tensor
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type exprOp int
+
+const (
+	opLeaf exprOp = iota
+	opAdd
+	opSub
+	opMul
+	opDiv
+	opScale
+	opMap
+	opBroadcast
+	opSum
+	opMean
+	opProd
+	opMin
+	opMax
+)
+
+var exprOpNames = [...]string{"tensor", "add", "sub", "mul", "div", "scale", "map", "broadcast", "sum", "mean", "prod", "min", "max"}
+
+// Expr is a lazily evaluated tensor expression. Building one only records the
+// operations and checks shapes; Eval runs every chain of elementwise
+// operations and broadcasts as a single fused loop without temporaries.
+// Reductions fuse with the elementwise chain below them and are a boundary for
+// the one above, which reads their result like an input tensor.
+//
+// Errors are deferred: an invalid operation yields an Expr whose Err, Eval and
+// every expression built on it report the error.
+type Expr[T Number] struct {
+	op       exprOp
+	shape    []int
+	args     []*Expr[T]
+	leaf     *Tensor[T]
+	c        T
+	fn       func(T) T
+	axes     []int
+	keepDims bool
+	err      error
+}
+
+func Lazy[T Number](t *Tensor[T]) *Expr[T] {
+	return &Expr[T]{op: opLeaf, shape: slices.Clone(t.Shape), leaf: t}
+}
+
+func (e *Expr[T]) Shape() []int {
+	return slices.Clone(e.shape)
+}
+
+func (e *Expr[T]) Err() error {
+	return e.err
+}
+
+func (e *Expr[T]) isReduction() bool {
+	return e.op >= opSum
+}
+
+func (e *Expr[T]) binary(op exprOp, other *Expr[T]) *Expr[T] {
+	out := &Expr[T]{op: op, args: []*Expr[T]{e, other}}
+	switch {
+	case e.err != nil:
+		out.err = e.err
+	case other.err != nil:
+		out.err = other.err
+	default:
+		out.shape, out.err = BroadcastShapes(e.shape, other.shape)
+	}
+	return out
+}
+
+func (e *Expr[T]) Add(other *Expr[T]) *Expr[T] {
+	return e.binary(opAdd, other)
+}
+
+func (e *Expr[T]) Sub(other *Expr[T]) *Expr[T] {
+	return e.binary(opSub, other)
+}
+
+func (e *Expr[T]) ElemMul(other *Expr[T]) *Expr[T] {
+	return e.binary(opMul, other)
+}
+
+func (e *Expr[T]) Div(other *Expr[T]) *Expr[T] {
+	return e.binary(opDiv, other)
+}
+
+func (e *Expr[T]) Scale(c T) *Expr[T] {
+	return &Expr[T]{op: opScale, shape: e.shape, args: []*Expr[T]{e}, c: c, err: e.err}
+}
+
+// Map applies fn to every element.
+func (e *Expr[T]) Map(fn func(T) T) *Expr[T] {
+	return &Expr[T]{op: opMap, shape: e.shape, args: []*Expr[T]{e}, fn: fn, err: e.err}
+}
+
+func (e *Expr[T]) BroadcastTo(shape ...int) *Expr[T] {
+	out := &Expr[T]{op: opBroadcast, shape: slices.Clone(shape), args: []*Expr[T]{e}, err: e.err}
+	if out.err == nil {
+		if s, err := BroadcastShapes(e.shape, shape); err != nil || !slices.Equal(s, shape) {
+			out.err = ErrShapeMismatch
+		}
+	}
+	return out
+}
+
+func (e *Expr[T]) reduce(op exprOp, keepDims bool, axes []int) *Expr[T] {
+	out := &Expr[T]{op: op, args: []*Expr[T]{e}, axes: slices.Clone(axes), keepDims: keepDims, err: e.err}
+	if out.err == nil {
+		r, err := newReducer[T, T](&Tensor[T]{Shape: e.shape}, axes)
+		if err != nil {
+			out.err = err
+			return out
+		}
+		// Как и в newAccumulator: у пустой свёртки нет среднего и экстремумов
+		if r.count == 0 && (op == opMean || op == opMin || op == opMax) {
+			out.err = ErrEmptyReduction
+			return out
+		}
+		out.shape = r.result(keepDims).Shape
+	}
+	return out
+}
+
+// Sum, Mean, Prod, Min and Max follow the functions of the same name.
+func (e *Expr[T]) Sum(keepDims bool, axes ...int) *Expr[T] {
+	return e.reduce(opSum, keepDims, axes)
+}
+
+func (e *Expr[T]) Mean(keepDims bool, axes ...int) *Expr[T] {
+	return e.reduce(opMean, keepDims, axes)
+}
+
+func (e *Expr[T]) Prod(keepDims bool, axes ...int) *Expr[T] {
+	return e.reduce(opProd, keepDims, axes)
+}
+
+func (e *Expr[T]) Min(keepDims bool, axes ...int) *Expr[T] {
+	return e.reduce(opMin, keepDims, axes)
+}
+
+func (e *Expr[T]) Max(keepDims bool, axes ...int) *Expr[T] {
+	return e.reduce(opMax, keepDims, axes)
+}
+
+// Eval evaluates e into a new tensor.
+func (e *Expr[T]) Eval() (out *Tensor[T], err error) {
+	const op = "Expr.Eval"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if e.err != nil {
+		return nil, e.err
+	}
+	out = NewTensor[T](e.shape...)
+	if err = e.evalInto(out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (e *Expr[T]) MustEval() *Tensor[T] {
+	out, err := e.Eval()
+	Must(err)
+	return out
+}
+
+// EvalInto evaluates e into dst, which must have the shape of e. dst may be
+// one of the inputs of e.
+func (e *Expr[T]) EvalInto(dst *Tensor[T]) (err error) {
+	const op = "Expr.EvalInto"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if e.err != nil {
+		return e.err
+	}
+	if !slices.Equal(dst.Shape, e.shape) {
+		return ErrShapeMismatch
+	}
+	return e.evalInto(dst)
+}
+
+// exprChunk is the number of positions a fused loop evaluates per pass over
+// its steps, small enough for all step buffers to stay in cache.
+const exprChunk = 512
+
+// fused is the elementwise tree of an Expr flattened into steps in evaluation
+// order. It runs chunk by chunk: every step fills a buffer of up to exprChunk
+// values from the buffers of its arguments in one tight loop.
+type fused[T Number] struct {
+	steps []step[T]
+	ts    []*Tensor[T] // inputs, broadcast to the loop shape
+	bufs  [][]T
+	vals  [][]T // values of every step in the current chunk
+}
+
+// step reads input in when it is a leaf, otherwise applies op to the values of
+// steps x and y.
+type step[T Number] struct {
+	op   exprOp
+	x, y int
+	in   int
+	c    T
+	fn   func(T) T
+}
+
+// flatten appends the steps of e and returns the index of its last one.
+// Leaves and reductions become inputs.
+func (e *Expr[T]) flatten(inputs *[]*Expr[T], steps *[]step[T]) int {
+	switch {
+	case e.op == opLeaf || e.isReduction():
+		k := slices.Index(*inputs, e)
+		if k >= 0 {
+			return slices.IndexFunc(*steps, func(s step[T]) bool { return s.op == opLeaf && s.in == k })
+		}
+		*inputs = append(*inputs, e)
+		*steps = append(*steps, step[T]{op: opLeaf, in: len(*inputs) - 1})
+	case e.op == opBroadcast:
+		return e.args[0].flatten(inputs, steps)
+	case e.op == opScale || e.op == opMap:
+		x := e.args[0].flatten(inputs, steps)
+		*steps = append(*steps, step[T]{op: e.op, x: x, c: e.c, fn: e.fn})
+	default:
+		x := e.args[0].flatten(inputs, steps)
+		y := e.args[1].flatten(inputs, steps)
+		*steps = append(*steps, step[T]{op: e.op, x: x, y: y})
+	}
+	return len(*steps) - 1
+}
+
+// compile flattens e and materializes its inputs for a loop over shape
+// writing into dst, which is nil for reductions.
+func (e *Expr[T]) compile(dst *Tensor[T], shape []int) (*fused[T], error) {
+	var inputs []*Expr[T]
+	f := &fused[T]{}
+	e.flatten(&inputs, &f.steps)
+	ts, err := materialize(inputs, dst, shape)
+	if err != nil {
+		return nil, err
+	}
+	f.ts = ts
+	f.bufs = make([][]T, len(f.steps))
+	f.vals = make([][]T, len(f.steps))
+	scratch := make([]T, len(f.steps)*exprChunk)
+	for s := range f.bufs {
+		f.bufs[s] = scratch[s*exprChunk : (s+1)*exprChunk]
+	}
+	return f, nil
+}
+
+func (f *fused[T]) contiguous() bool {
+	for _, t := range f.ts {
+		if !t.IsContiguous() {
+			return false
+		}
+	}
+	return true
+}
+
+// run evaluates n positions and returns the values of the last step, written
+// into out unless out is nil. load returns the n values of input k, either
+// from its own data or gathered into buf.
+func (f *fused[T]) run(n int, load func(k int, buf []T) []T, out []T) []T {
+	last := len(f.steps) - 1
+	for s := range f.steps {
+		st := &f.steps[s]
+		d := f.bufs[s][:n]
+		if s == last && out != nil {
+			d = out[:n]
+		}
+		if st.op == opLeaf {
+			v := load(st.in, f.bufs[s][:n])
+			if s == last && out != nil {
+				copy(d, v)
+				v = d
+			}
+			f.vals[s] = v
+			continue
+		}
+
+		x := f.vals[st.x][:len(d)]
+		switch st.op {
+		case opScale:
+			c := st.c
+			for j := range d {
+				d[j] = x[j] * c
+			}
+		case opMap:
+			fn := st.fn
+			for j := range d {
+				d[j] = fn(x[j])
+			}
+		case opAdd:
+			y := f.vals[st.y][:len(d)]
+			for j := range d {
+				d[j] = x[j] + y[j]
+			}
+		case opSub:
+			y := f.vals[st.y][:len(d)]
+			for j := range d {
+				d[j] = x[j] - y[j]
+			}
+		case opMul:
+			y := f.vals[st.y][:len(d)]
+			for j := range d {
+				d[j] = x[j] * y[j]
+			}
+		case opDiv:
+			y := f.vals[st.y][:len(d)]
+			for j := range d {
+				d[j] = x[j] / y[j]
+			}
+		}
+		f.vals[s] = d
+	}
+	return f.vals[last]
+}
+
+// slice loads chunk [i0, i0+n) of contiguous inputs without copying.
+func (f *fused[T]) slice(i0, n int) func(k int, _ []T) []T {
+	return func(k int, _ []T) []T {
+		t := f.ts[k]
+		return t.Data[t.Offset+i0:][:n]
+	}
+}
+
+// gather loads input k from the offsets of layout k+1 of a chunk; layout 0
+// belongs to the output.
+func (f *fused[T]) gather(offs [][]int) func(k int, buf []T) []T {
+	return func(k int, buf []T) []T {
+		data := f.ts[k].Data
+		for j, o := range offs[k+1][:len(buf)] {
+			buf[j] = data[o]
+		}
+		return buf
+	}
+}
+
+// chunks walks it, whose first layout is the output, in runs of up to
+// exprChunk positions and calls fn with the offsets of every layout.
+func (f *fused[T]) chunks(it *Iterator, fn func(n int, offs [][]int)) {
+	offs := make([][]int, len(f.ts)+1)
+	for k := range offs {
+		offs[k] = make([]int, exprChunk)
+	}
+	n := 0
+	for it.Next() {
+		for k := range offs {
+			offs[k][n] = it.Offset(k)
+		}
+		if n++; n == exprChunk {
+			fn(n, offs)
+			n = 0
+		}
+	}
+	if n > 0 {
+		fn(n, offs)
+	}
+}
+
+func (f *fused[T]) iterator(shape []int, out layout) *Iterator {
+	ls := []layout{out}
+	for _, t := range f.ts {
+		ls = append(ls, t.layout())
+	}
+	return newLayoutIterator(shape, ls...)
+}
+
+// materialize returns the tensors behind inputs, broadcast to shape.
+// Reductions are evaluated first. An input sharing data with dst under a
+// different layout is copied, so that the loop cannot overwrite unread values.
+func materialize[T Number](inputs []*Expr[T], dst *Tensor[T], shape []int) ([]*Tensor[T], error) {
+	ts := make([]*Tensor[T], len(inputs))
+	for k, in := range inputs {
+		t := in.leaf
+		if in.isReduction() {
+			t = NewTensor[T](in.shape...)
+			if err := in.evalInto(t); err != nil {
+				return nil, err
+			}
+		}
+		if !slices.Equal(t.Shape, shape) {
+			b, err := BroadcastTo(t, shape...)
+			if err != nil {
+				return nil, err
+			}
+			t = b
+		}
+		if dst != nil {
+			t = detach(dst, t)
+		}
+		ts[k] = t
+	}
+	return ts, nil
+}
+
+func (e *Expr[T]) evalInto(dst *Tensor[T]) error {
+	if e.isReduction() {
+		return e.reduceInto(dst)
+	}
+
+	f, err := e.compile(dst, e.shape)
+	if err != nil {
+		return err
+	}
+	if dst.IsContiguous() && f.contiguous() {
+		d := dst.contiguousData()
+		for i0 := 0; i0 < len(d); i0 += exprChunk {
+			n := min(exprChunk, len(d)-i0)
+			f.run(n, f.slice(i0, n), d[i0:i0+n])
+		}
+		return nil
+	}
+
+	f.chunks(f.iterator(e.shape, dst.layout()), func(n int, offs [][]int) {
+		for j, v := range f.run(n, f.gather(offs), nil) {
+			dst.Data[offs[0][j]] = v
+		}
+	})
+	return nil
+}
+
+// reduceInto evaluates the elementwise input of the reduction e in the same
+// loop that accumulates it.
+func (e *Expr[T]) reduceInto(dst *Tensor[T]) error {
+	in := e.args[0]
+	f, err := in.compile(nil, in.shape)
+	if err != nil {
+		return err
+	}
+	// Только форма нужна для разметки выходного тензора
+	r, err := newReducer[T, T](&Tensor[T]{Shape: in.shape}, e.axes)
+	if err != nil {
+		return err
+	}
+	// opSum..opMax идут в том же порядке, что и reduceSum..reduceMax
+	acc, err := newAccumulator(reduceOp(e.op-opSum), r.out.Data, r.count)
+	if err != nil {
+		return err
+	}
+
+	if len(r.out.Data) == 1 && f.contiguous() {
+		for i0 := 0; i0 < r.count; i0 += exprChunk {
+			n := min(exprChunk, r.count-i0)
+			for _, v := range f.run(n, f.slice(i0, n), nil) {
+				acc.add(0, v)
+			}
+		}
+	} else {
+		f.chunks(f.iterator(in.shape, r.acc.layout()), func(n int, offs [][]int) {
+			for j, v := range f.run(n, f.gather(offs), nil) {
+				acc.add(offs[0][j], v)
+			}
+		})
+	}
+	acc.finish()
+	copyInto(dst, r.result(e.keepDims))
+	return nil
+}
+
+// Plan describes the fused loops Eval runs, in execution order. Inputs are
+// numbered per loop: %k is a tensor or the result of an earlier loop.
+func (e *Expr[T]) Plan() string {
+	if e.err != nil {
+		return "error: " + e.err.Error()
+	}
+	var b strings.Builder
+	loops := map[*Expr[T]]int{}
+	e.plan(&b, loops)
+	return b.String()
+}
+
+func (e *Expr[T]) plan(b *strings.Builder, loops map[*Expr[T]]int) int {
+	if n, ok := loops[e]; ok {
+		return n
+	}
+	body, shape := e, e.shape
+	if e.isReduction() {
+		body, shape = e.args[0], e.args[0].shape
+	}
+	var inputs []*Expr[T]
+	text := body.describe(&inputs)
+	if e.isReduction() {
+		axes := "all"
+		if len(e.axes) > 0 {
+			axes = fmt.Sprint(e.axes)
+		}
+		keep := ""
+		if e.keepDims {
+			keep = " keepDims"
+		}
+		text = fmt.Sprintf("%s(%s, axes=%s%s)", exprOpNames[e.op], text, axes, keep)
+	}
+
+	// Вложенные свертки выполняются раньше
+	sources := make([]string, len(inputs))
+	for k, in := range inputs {
+		if in.isReduction() {
+			sources[k] = fmt.Sprintf("loop %d", in.plan(b, loops))
+			continue
+		}
+		sources[k] = fmt.Sprintf("tensor %v", in.shape)
+		if !slices.Equal(in.shape, shape) {
+			sources[k] += fmt.Sprintf(" broadcast to %v", shape)
+		}
+	}
+
+	n := len(loops) + 1
+	loops[e] = n
+	fmt.Fprintf(b, "loop %d %v: %s\n", n, e.shape, text)
+	for k, s := range sources {
+		fmt.Fprintf(b, "  %%%d = %s\n", k, s)
+	}
+	return n
+}
+
+// describe mirrors flatten and renders the tree as text.
+func (e *Expr[T]) describe(inputs *[]*Expr[T]) string {
+	switch {
+	case e.op == opLeaf || e.isReduction():
+		k := slices.Index(*inputs, e)
+		if k < 0 {
+			k = len(*inputs)
+			*inputs = append(*inputs, e)
+		}
+		return fmt.Sprintf("%%%d", k)
+	case e.op == opBroadcast:
+		return e.args[0].describe(inputs)
+	case e.op == opScale:
+		return fmt.Sprintf("scale(%s, %v)", e.args[0].describe(inputs), e.c)
+	case e.op == opMap:
+		return fmt.Sprintf("map(%s)", e.args[0].describe(inputs))
+	}
+	l := e.args[0].describe(inputs)
+	r := e.args[1].describe(inputs)
+	return fmt.Sprintf("%s(%s, %s)", exprOpNames[e.op], l, r)
+}
@@ -0,0 +1,126 @@
+package tensor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExpr(t *testing.T) {
+	Convey("Given some tensors", t, func() {
+		a := NewTensor[float64](2, 3)
+		b := NewTensor[float64](2, 3)
+		for i := range a.Data {
+			a.Data[i] = float64(i + 1)
+			b.Data[i] = float64(2*i - 3)
+		}
+		c := NewTensor[float64](3)
+		c.Data = []float64{1, 10, 100}
+
+		Convey("A fused chain matches the eager operations", func() {
+			got, err := Lazy(a).Scale(2).Add(Lazy(b).ElemMul(Lazy(c))).Eval()
+			So(err, ShouldBeNil)
+
+			bc, _ := ElemMul(b, c)
+			want, _ := Add(Scale(a, 2), bc)
+			expectTensorEqual(t, got, want)
+		})
+
+		Convey("Sub, Div, Map and BroadcastTo are supported", func() {
+			e := Lazy(c).BroadcastTo(2, 3).Sub(Lazy(a)).Div(Lazy(a)).Map(func(v float64) float64 { return v * v })
+			So(e.Shape(), ShouldResemble, []int{2, 3})
+			got := e.MustEval()
+			So(got.MustAt(1, 2), ShouldAlmostEqual, (94.0/6)*(94.0/6))
+		})
+
+		Convey("Reductions fuse with their input", func() {
+			got, err := Lazy(a).ElemMul(Lazy(b)).Sum(false, 1).Eval()
+			So(err, ShouldBeNil)
+			prod, _ := ElemMul(a, b)
+			want, _ := Sum(prod, false, 1)
+			expectTensorEqual(t, got, want)
+
+			for _, c := range []struct {
+				lazy  *Expr[float64]
+				eager func(*Tensor[float64], bool, ...int) (*Tensor[float64], error)
+			}{
+				{Lazy(b).Mean(true, 0), Mean[float64]},
+				{Lazy(b).Prod(false), Prod[float64]},
+				{Lazy(b).Min(false, 1), Min[float64]},
+				{Lazy(b).Max(true), Max[float64]},
+			} {
+				got, err := c.lazy.Eval()
+				So(err, ShouldBeNil)
+				want, _ := c.eager(b, c.lazy.keepDims, c.lazy.axes...)
+				expectTensorEqual(t, got, want)
+			}
+		})
+
+		Convey("A reduction result feeds the next loop", func() {
+			// a - mean(a, axis 1)
+			e := Lazy(a).Sub(Lazy(a).Mean(true, 1))
+			got := e.MustEval()
+			So(got.Data, ShouldResemble, []float64{-1, 0, 1, -1, 0, 1})
+			So(e.Plan(), ShouldEqual, ""+
+				"loop 1 [2 1]: mean(%0, axes=[1] keepDims)\n"+
+				"  %0 = tensor [2 3]\n"+
+				"loop 2 [2 3]: sub(%0, %1)\n"+
+				"  %0 = tensor [2 3]\n"+
+				"  %1 = loop 1\n")
+		})
+
+		Convey("Plan shows one loop for an elementwise chain", func() {
+			e := Lazy(a).Scale(2).Add(Lazy(b).ElemMul(Lazy(c)))
+			So(e.Plan(), ShouldEqual, ""+
+				"loop 1 [2 3]: add(scale(%0, 2), mul(%1, %2))\n"+
+				"  %0 = tensor [2 3]\n"+
+				"  %1 = tensor [2 3]\n"+
+				"  %2 = tensor [3] broadcast to [2 3]\n")
+		})
+
+		Convey("EvalInto writes into a destination, even an input", func() {
+			dst := NewTensor[float64](2, 3)
+			So(Lazy(a).Add(Lazy(b)).EvalInto(dst), ShouldBeNil)
+			want, _ := Add(a, b)
+			expectTensorEqual(t, dst, want)
+
+			sq := NewTensor[float64](2, 2)
+			sq.Data = []float64{1, 2, 3, 4}
+			So(Lazy(sq).Add(Lazy(sq.MustTranspose())).EvalInto(sq), ShouldBeNil)
+			So(sq.Data, ShouldResemble, []float64{2, 5, 5, 8})
+
+			So(Lazy(a).EvalInto(NewTensor[float64](3, 2)), ShouldNotBeNil)
+		})
+
+		Convey("Errors are deferred to Eval", func() {
+			bad := Lazy(a).Add(Lazy(NewTensor[float64](4)))
+			So(errors.Is(bad.Err(), ErrShapeMismatch), ShouldBeTrue)
+			_, err := bad.Scale(2).Sum(false).Eval()
+			So(errors.Is(err, ErrShapeMismatch), ShouldBeTrue)
+			So(bad.Plan(), ShouldStartWith, "error:")
+
+			_, err = Lazy(a).Sum(false, 2).Eval()
+			So(errors.Is(err, ErrInvalidAxis), ShouldBeTrue)
+			So(Lazy(c).BroadcastTo(3, 2).Err(), ShouldNotBeNil)
+
+			empty := Lazy(NewTensor[float64](0, 3))
+			for _, e := range []*Expr[float64]{
+				empty.Mean(false, 0), empty.Min(false, 0), empty.Max(true), empty.Min(false).Scale(2),
+			} {
+				So(errors.Is(e.Err(), ErrEmptyReduction), ShouldBeTrue)
+			}
+			So(empty.Sum(false, 0).Err(), ShouldBeNil)
+			So(empty.Max(false, 1).Err(), ShouldBeNil)
+		})
+	})
+	Convey("Integer means match the eager reduction", t, func() {
+		u := NewTensor[uint8](256)
+		for i := range u.Data {
+			u.Data[i] = 200
+		}
+		got, err := Lazy(u).Add(Lazy(u).Scale(0)).Mean(false).Eval()
+		So(err, ShouldBeNil)
+		So(got.MustAt(), ShouldEqual, uint8(200))
+	})
+}
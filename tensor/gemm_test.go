@@ -0,0 +1,72 @@
+package tensor
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// naiveProduct is the reference triple loop.
+func naiveProduct[T Number](a, b *Matrix[T]) []T {
+	m, k, n := a.Shape[0], a.Shape[1], b.Shape[1]
+	out := make([]T, m*n)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			for p := 0; p < k; p++ {
+				out[i*n+j] += a.MustAt(i, p) * b.MustAt(p, j)
+			}
+		}
+	}
+	return out
+}
+
+func TestGemm(t *testing.T) {
+	Convey("The blocked kernel matches the reference product", t, func() {
+		for _, dims := range [][3]int{{1, 1, 1}, {5, 7, 3}, {67, 131, 9}, {130, 70, 65}} {
+			m, k, n := dims[0], dims[1], dims[2]
+			a := NewMatrix[int](m, k)
+			RandomTensorN(a.Tensor, 10)
+			b := NewMatrix[int](k, n)
+			RandomTensorN(b.Tensor, 10)
+			want := naiveProduct(a, b)
+
+			for _, workers := range []int{1, 3} {
+				out := NewMatrix[int](m, n)
+				gemm(out, a, b, workers)
+				So(out.Data, ShouldResemble, want)
+			}
+		}
+	})
+
+	Convey("Strided operands and outputs are handled", t, func() {
+		a := NewMatrix[complex128](40, 30)
+		RandomTensor(a.Tensor)
+		at := &Matrix[complex128]{a.MustTranspose()}
+		b := NewMatrix[complex128](40, 20)
+		RandomTensor(b.Tensor)
+
+		out := NewMatrix[complex128](20, 30)
+		outT := &Matrix[complex128]{out.MustTranspose()}
+		gemm(outT, at, b, 2)
+		want := naiveProduct(at, b)
+		for i := 0; i < 30; i++ {
+			for j := 0; j < 20; j++ {
+				So(cmplxDist(outT.MustAt(i, j), want[i*20+j]), ShouldBeLessThan, 1e-9)
+			}
+		}
+	})
+
+	Convey("MatMul uses the configured worker count", t, func() {
+		defer SetMatMulWorkers(0)
+		SetMatMulWorkers(4)
+		So(matMulWorkerCount(), ShouldEqual, 4)
+		a := NewMatrix[float64](64, 64)
+		RandomTensor(a.Tensor)
+		out, err := MatMul(a, Identity[float64](64))
+		So(err, ShouldBeNil)
+		So(out.Data, ShouldResemble, a.Data)
+
+		SetMatMulWorkers(-1)
+		So(matMulWorkerCount(), ShouldBeGreaterThan, 0)
+	})
+}
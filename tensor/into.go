@@ -0,0 +1,127 @@
+package tensor
+
+// The …Into functions are the destination forms of the functional
+// operations: they check dst against the shape of the result and write into
+// it. They do not allocate unless an operand has to be broadcast or overlaps
+// dst with a different layout, in which case that operand is copied first.
+
+func elementwiseInto[T Number](dst, a, b *Tensor[T], op func(T, T) T) error {
+	a, b, err := broadcastPair(a, b)
+	if err != nil {
+		return err
+	}
+	if !SameShape(dst, a) {
+		return ErrShapeMismatch
+	}
+	zipInto(dst, detach(dst, a), detach(dst, b), op)
+	return nil
+}
+
+// detach returns src, or a copy of it if src shares data with dst under a
+// different layout, so that writing dst cannot clobber unread elements.
+func detach[T Number](dst, src *Tensor[T]) *Tensor[T] {
+	if sharesData(dst, src) && !sameLayout(dst, src) {
+		return src.Copy()
+	}
+	return src
+}
+
+func AddInto[T Number](dst, a, b *Tensor[T]) (err error) {
+	const op = "AddInto"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return elementwiseInto(dst, a, b, func(a, b T) T { return a + b })
+}
+
+func SubInto[T Number](dst, a, b *Tensor[T]) (err error) {
+	const op = "SubInto"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return elementwiseInto(dst, a, b, func(a, b T) T { return a - b })
+}
+
+func ElemMulInto[T Number](dst, a, b *Tensor[T]) (err error) {
+	const op = "ElemMulInto"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return elementwiseInto(dst, a, b, func(a, b T) T { return a * b })
+}
+
+func DivInto[T Number](dst, a, b *Tensor[T]) (err error) {
+	const op = "DivInto"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return elementwiseInto(dst, a, b, func(a, b T) T { return a / b })
+}
+
+func ScaleInto[T Number](dst, a *Tensor[T], c T) (err error) {
+	const op = "ScaleInto"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if !SameShape(dst, a) {
+		return ErrShapeMismatch
+	}
+	mapInto(dst, detach(dst, a), func(v T) T { return v * c })
+	return nil
+}
+
+// TransposeInto writes the permutation of t into dst. Unlike Transpose, which
+// returns a view, the data is copied in the new order.
+func TransposeInto[T Number](dst, t *Tensor[T], order ...int) (err error) {
+	const op = "TransposeInto"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	// Матрица без явного порядка: без промежуточного представления
+	if len(order) == 0 && len(t.Shape) == 2 && !sharesData(dst, t) {
+		r, c := t.Shape[0], t.Shape[1]
+		if len(dst.Shape) != 2 || dst.Shape[0] != c || dst.Shape[1] != r {
+			return ErrShapeMismatch
+		}
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				dst.Data[dst.Offset+j*dst.Strides[0]+i*dst.Strides[1]] = t.Data[t.Offset+i*t.Strides[0]+j*t.Strides[1]]
+			}
+		}
+		return nil
+	}
+
+	view, err := Transpose(t, order...)
+	if err != nil {
+		return err
+	}
+	if !SameShape(dst, view) {
+		return ErrShapeMismatch
+	}
+	copyInto(dst, detach(dst, view))
+	return nil
+}
+
+// MatMulInto stores a·b in dst, overwriting its contents.
+func MatMulInto[T Number](dst, a, b *Matrix[T]) (err error) {
+	const op = "MatMulInto"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if a.Shape[1] != b.Shape[0] || dst.Shape[0] != a.Shape[0] || dst.Shape[1] != b.Shape[1] {
+		return ErrShapeMismatch
+	}
+	// Результат нельзя писать поверх операнда, который ещё читается
+	if sharesData(dst.Tensor, a.Tensor) {
+		a = &Matrix[T]{a.Copy()}
+	}
+	if sharesData(dst.Tensor, b.Tensor) {
+		b = &Matrix[T]{b.Copy()}
+	}
+	mapInto(dst.Tensor, dst.Tensor, func(T) T { return 0 })
+	matMulInto(dst, a, b, matMulWorkerCount())
+	return nil
+}
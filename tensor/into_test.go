@@ -0,0 +1,138 @@
+package tensor
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestInto(t *testing.T) {
+	Convey("Given operands and a destination", t, func() {
+		a := NewTensor[float64](3, 4)
+		b := NewTensor[float64](3, 4)
+		for i := range a.Data {
+			a.Data[i] = float64(i + 1)
+			b.Data[i] = float64(2 * i)
+		}
+		dst := NewTensor[float64](3, 4)
+
+		Convey("Elementwise forms match the allocating ones", func() {
+			for _, c := range []struct {
+				into  func(dst, a, b *Tensor[float64]) error
+				alloc func(a, b *Tensor[float64]) (*Tensor[float64], error)
+			}{
+				{AddInto[float64], Add[float64]},
+				{SubInto[float64], Sub[float64]},
+				{ElemMulInto[float64], ElemMul[float64]},
+				{DivInto[float64], Div[float64]},
+			} {
+				So(c.into(dst, a, b), ShouldBeNil)
+				want, _ := c.alloc(a, b)
+				So(dst.Data, ShouldResemble, want.Data)
+			}
+			So(ScaleInto(dst, a, 2), ShouldBeNil)
+			So(dst.Data, ShouldResemble, Scale(a, 2).Data)
+		})
+
+		Convey("Broadcast operands are accepted, wrong destinations are not", func() {
+			row := NewTensor[float64](4)
+			row.Data = []float64{1, 2, 3, 4}
+			So(AddInto(dst, a, row), ShouldBeNil)
+			So(dst.MustAt(2, 3), ShouldEqual, 16)
+
+			So(AddInto(NewTensor[float64](4, 3), a, b), ShouldNotBeNil)
+			So(ScaleInto(NewTensor[float64](12), a, 2), ShouldNotBeNil)
+		})
+
+		Convey("A destination overlapping an operand gives the right result", func() {
+			m := NewTensor[float64](2, 2)
+			m.Data = []float64{1, 2, 3, 4}
+			So(AddInto(m, m, m.MustTranspose()), ShouldBeNil)
+			So(m.Data, ShouldResemble, []float64{2, 5, 5, 8})
+
+			n := NewTensor[float64](2, 2)
+			n.Data = []float64{1, 2, 3, 4}
+			So(TransposeInto(n, n), ShouldBeNil)
+			So(n.Data, ShouldResemble, []float64{1, 3, 2, 4})
+		})
+
+		Convey("TransposeInto copies in transposed order", func() {
+			out := NewTensor[float64](4, 3)
+			So(TransposeInto(out, a), ShouldBeNil)
+			So(out.MustAt(3, 1), ShouldEqual, a.MustAt(1, 3))
+			So(out.IsContiguous(), ShouldBeTrue)
+
+			cube := NewTensor[float64](2, 3, 4)
+			So(TransposeInto(cube, a.MustBroadcastTo(2, 3, 4), 0, 1, 2), ShouldBeNil)
+			So(TransposeInto(cube, a), ShouldNotBeNil)
+		})
+
+		Convey("MatMulInto overwrites the destination", func() {
+			x := &Matrix[float64]{a}
+			y := &Matrix[float64]{b.MustTranspose()}
+			out := NewMatrix[float64](3, 3)
+			RandomTensor(out.Tensor)
+			So(MatMulInto(out, x, y), ShouldBeNil)
+			want, _ := MatMul(x, y)
+			So(out.Data, ShouldResemble, want.Data)
+
+			sq := NewMatrix[float64](3, 3)
+			sq.Data = []float64{1, 2, 0, 0, 1, 0, 0, 0, 2}
+			So(MatMulInto(sq, sq, sq), ShouldBeNil)
+			So(sq.Data, ShouldResemble, []float64{1, 4, 0, 0, 1, 0, 0, 0, 4})
+			So(MatMulInto(sq, x, x), ShouldNotBeNil)
+		})
+
+		Convey("Methods work in place", func() {
+			c := a.Copy()
+			So(c.Scale(2), ShouldEqual, c)
+			So(c.Data, ShouldResemble, Scale(a, 2).Data)
+
+			sq := NewTensor[float64](2, 2)
+			sq.Data = []float64{1, 2, 3, 4}
+			row := sq.MustSlice(Range{0, 1, 1})
+			So(sq.Mul(sq), ShouldBeNil)
+			So(sq.Data, ShouldResemble, []float64{7, 10, 15, 22})
+			So(row.MustAt(0, 1), ShouldEqual, 10.0)
+
+			// Форма меняется: t получает новый буфер
+			wide := sq.Copy()
+			So(wide.Mul(NewTensor[float64](2, 3)), ShouldBeNil)
+			So(wide.Shape, ShouldResemble, []int{2, 3})
+		})
+	})
+}
+
+func TestIntoAllocations(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are not meaningful under the race detector")
+	}
+	Convey("Steady-state loops do not allocate", t, func() {
+		defer SetMatMulWorkers(0)
+		SetMatMulWorkers(1)
+
+		a := NewMatrix[float64](64, 64)
+		RandomTensor(a.Tensor)
+		b := NewMatrix[float64](64, 64)
+		RandomTensor(b.Tensor)
+		dst := NewMatrix[float64](64, 64)
+		small := NewMatrix[float64](4, 4)
+		tr := NewMatrix[float64](64, 64)
+		sa, sb := a.MustSubMatrix(0, 4, 0, 4), b.MustSubMatrix(0, 4, 0, 4)
+
+		allocs := testing.AllocsPerRun(20, func() {
+			AddInto(dst.Tensor, a.Tensor, b.Tensor)
+			SubInto(dst.Tensor, dst.Tensor, a.Tensor)
+			ElemMulInto(dst.Tensor, dst.Tensor, b.Tensor)
+			DivInto(dst.Tensor, dst.Tensor, b.Tensor)
+			ScaleInto(dst.Tensor, dst.Tensor, 0.5)
+			TransposeInto(tr.Tensor, dst.Tensor)
+			MatMulInto(dst, a, tr)
+			MatMulInto(small, sa, sb)
+			dst.Add(a.Tensor)
+			dst.Scale(0.5)
+			dst.Mul(b.Tensor)
+		})
+		So(allocs, ShouldEqual, 0)
+	})
+}
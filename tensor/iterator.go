@@ -0,0 +1,201 @@
+package tensor
+
+// Iterator walks a shape in logical row-major order and tracks, for every operand,
+// the offset into its Data according to the operand's own Offset and Strides.
+// Operands may therefore be transposed, sliced or otherwise non-contiguous.
+type Iterator struct {
+	shape   []int
+	strides [][]int
+	base    []int
+	offsets []int
+	index   []int
+	size    int
+	pos     int
+}
+
+// NewIterator returns an iterator over tensors of the same shape.
+func NewIterator[T Number](ts ...*Tensor[T]) (*Iterator, error) {
+	if len(ts) == 0 {
+		return &Iterator{}, nil
+	}
+	for _, t := range ts[1:] {
+		if !SameShape(ts[0], t) {
+			return nil, ErrShapeMismatch
+		}
+	}
+	return newIterator(ts[0].Shape, ts...), nil
+}
+
+// layout is the part of a tensor the iterator needs, independent of the element type.
+type layout struct {
+	offset  int
+	strides []int
+}
+
+func (t *Tensor[T]) layout() layout {
+	return layout{offset: t.Offset, strides: t.Strides}
+}
+
+// newIterator does not check shapes: every operand must have at least len(shape) strides.
+func newIterator[T Number](shape []int, ts ...*Tensor[T]) *Iterator {
+	ls := make([]layout, len(ts))
+	for k, t := range ts {
+		ls[k] = t.layout()
+	}
+	return newLayoutIterator(shape, ls...)
+}
+
+func newLayoutIterator(shape []int, ls ...layout) *Iterator {
+	it := &Iterator{
+		shape:   shape,
+		strides: make([][]int, len(ls)),
+		base:    make([]int, len(ls)),
+		offsets: make([]int, len(ls)),
+		index:   make([]int, len(shape)),
+		size:    1,
+	}
+	for k, l := range ls {
+		it.strides[k] = l.strides
+		it.base[k] = l.offset
+	}
+	for _, d := range shape {
+		it.size *= d
+	}
+	it.Reset()
+	return it
+}
+
+// Reset rewinds the iterator to the position before the first element.
+func (it *Iterator) Reset() {
+	it.pos = -1
+	copy(it.offsets, it.base)
+	clear(it.index)
+}
+
+// Next advances to the next element and reports whether there is one.
+func (it *Iterator) Next() bool {
+	it.pos++
+	if it.pos >= it.size {
+		it.pos = it.size
+		return false
+	}
+	if it.pos == 0 {
+		return true
+	}
+	for axis := len(it.shape) - 1; axis >= 0; axis-- {
+		it.index[axis]++
+		if it.index[axis] < it.shape[axis] {
+			for k, s := range it.strides {
+				it.offsets[k] += s[axis]
+			}
+			return true
+		}
+		it.index[axis] = 0
+		for k, s := range it.strides {
+			it.offsets[k] -= s[axis] * (it.shape[axis] - 1)
+		}
+	}
+	return true
+}
+
+// Offset returns the current Data offset of operand k.
+func (it *Iterator) Offset(k int) int {
+	return it.offsets[k]
+}
+
+// Index returns the current logical index. The slice is reused between calls.
+func (it *Iterator) Index() []int {
+	return it.index
+}
+
+// Pos returns the row-major position of the current element.
+func (it *Iterator) Pos() int {
+	return it.pos
+}
+
+// IsContiguous reports whether the elements of t occupy Data[Offset:Offset+size]
+// in row-major order.
+func (t *Tensor[T]) IsContiguous() bool {
+	stride := 1
+	for i := len(t.Shape) - 1; i >= 0; i-- {
+		if t.Shape[i] == 0 {
+			return true
+		}
+		if t.Shape[i] != 1 && t.Strides[i] != stride {
+			return false
+		}
+		stride *= t.Shape[i]
+	}
+	return true
+}
+
+// Contiguous returns t itself if it is contiguous and a compact copy otherwise.
+func (t *Tensor[T]) Contiguous() *Tensor[T] {
+	if t.IsContiguous() {
+		return t
+	}
+	return t.Copy()
+}
+
+func (t *Tensor[T]) contiguousData() []T {
+	return t.Data[t.Offset : t.Offset+t.size]
+}
+
+func sharesData[T Number](a, b *Tensor[T]) bool {
+	return len(a.Data) > 0 && len(b.Data) > 0 && &a.Data[0] == &b.Data[0]
+}
+
+func sameLayout[T Number](a, b *Tensor[T]) bool {
+	if a.Offset != b.Offset || len(a.Strides) != len(b.Strides) {
+		return false
+	}
+	for i := range a.Strides {
+		if a.Strides[i] != b.Strides[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// copyInto copies src into dst element by element. Shapes must match.
+func copyInto[T Number](dst, src *Tensor[T]) {
+	if dst.IsContiguous() && src.IsContiguous() {
+		copy(dst.contiguousData(), src.contiguousData())
+		return
+	}
+	it := newIterator(dst.Shape, dst, src)
+	for it.Next() {
+		dst.Data[it.offsets[0]] = src.Data[it.offsets[1]]
+	}
+}
+
+// mapInto sets dst = fn(a) elementwise. Shapes must match.
+func mapInto[T Number](dst, a *Tensor[T], fn func(T) T) {
+	if dst.IsContiguous() && a.IsContiguous() {
+		d, ad := dst.contiguousData(), a.contiguousData()
+		for i := range d {
+			d[i] = fn(ad[i])
+		}
+		return
+	}
+	it := newIterator(dst.Shape, dst, a)
+	for it.Next() {
+		dst.Data[it.offsets[0]] = fn(a.Data[it.offsets[1]])
+	}
+}
+
+// zipInto sets dst = fn(a, b) elementwise. Shapes must match; dst may alias a or b
+// only if it has the same layout.
+func zipInto[T Number](dst, a, b *Tensor[T], fn func(T, T) T) {
+	if dst.IsContiguous() && a.IsContiguous() && b.IsContiguous() {
+		d, ad, bd := dst.contiguousData(), a.contiguousData(), b.contiguousData()
+		for i := range d {
+			d[i] = fn(ad[i], bd[i])
+		}
+		return
+	}
+	it := newIterator(dst.Shape, dst, a, b)
+	for it.Next() {
+		dst.Data[it.offsets[0]] = fn(a.Data[it.offsets[1]], b.Data[it.offsets[2]])
+	}
+}
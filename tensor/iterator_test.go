@@ -0,0 +1,72 @@
+package tensor
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStridedIteration(t *testing.T) {
+	Convey("Given a 2x3 tensor and its transpose", t, func() {
+		a := NewTensor[int](2, 3)
+		for i := range a.Data {
+			a.Data[i] = i + 1
+		}
+		tr := a.MustTranspose()
+
+		Convey("Iterator visits elements in logical order", func() {
+			it, err := NewIterator(tr)
+			So(err, ShouldBeNil)
+			var got []int
+			for it.Next() {
+				got = append(got, tr.Data[it.Offset(0)])
+			}
+			So(got, ShouldResemble, []int{1, 4, 2, 5, 3, 6})
+
+			it.Reset()
+			So(it.Next(), ShouldBeTrue)
+			So(it.Index(), ShouldResemble, []int{0, 0})
+		})
+
+		Convey("NewIterator rejects different shapes", func() {
+			_, err := NewIterator(a, tr)
+			So(err, ShouldEqual, ErrShapeMismatch)
+		})
+
+		Convey("IsContiguous and Contiguous", func() {
+			So(a.IsContiguous(), ShouldBeTrue)
+			So(tr.IsContiguous(), ShouldBeFalse)
+			So(a.MustSlice(Span(1, 2)).IsContiguous(), ShouldBeTrue)
+			So(a.MustSlice(All(), Span(0, 2)).IsContiguous(), ShouldBeFalse)
+
+			So(a.Contiguous(), ShouldEqual, a)
+			c := tr.Contiguous()
+			So(c.Data, ShouldResemble, []int{1, 4, 2, 5, 3, 6})
+			So(c.Strides, ShouldResemble, []int{2, 1})
+		})
+
+		Convey("Elementwise ops use logical indices", func() {
+			b := NewTensor[int](3, 2)
+			for i := range b.Data {
+				b.Data[i] = 10 * (i + 1)
+			}
+			sum, err := Add(tr, b)
+			So(err, ShouldBeNil)
+			So(sum.Data, ShouldResemble, []int{11, 24, 32, 45, 53, 66})
+
+			So(Scale(tr, 2).Data, ShouldResemble, []int{2, 8, 4, 10, 6, 12})
+			So(Equal(tr, tr.Copy()), ShouldBeTrue)
+
+			flat := NewTensor[int](3, 2)
+			copy(flat.Data, a.Data)
+			So(Equal(tr, flat), ShouldBeFalse)
+		})
+
+		Convey("In-place ops handle overlapping operands", func() {
+			sq := NewTensor[int](2, 2)
+			sq.Data = []int{1, 2, 3, 4}
+			So(sq.Add(sq.MustTranspose()), ShouldBeNil)
+			So(sq.Data, ShouldResemble, []int{2, 5, 5, 8})
+		})
+	})
+}
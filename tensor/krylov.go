@@ -0,0 +1,438 @@
+package tensor
+
+import (
+	"math"
+	"slices"
+)
+
+// LinearOperator is a linear map given only by its action on vectors, so that
+// iterative solvers work with dense, structured or matrix-free operators alike.
+type LinearOperator[T Number] interface {
+	Dims() (rows, cols int)
+	Apply(x *Vector[T]) (*Vector[T], error)
+}
+
+// IterOptions configures the iterative solvers. A nil *IterOptions or zero
+// fields select the defaults.
+type IterOptions[T Number] struct {
+	Tol     float64           // relative residual ‖b - A·x‖/‖b‖, default √eps
+	MaxIter int               // default 10·n
+	Precond LinearOperator[T] // applies an approximation of A⁻¹, nil for none
+	Restart int               // GMRES restart length, default min(n, 30)
+	X0      *Vector[T]        // initial guess, default zero
+}
+
+// IterResult is the outcome of an iterative solve. History[0] is the relative
+// residual of the initial guess and History[k] the one after iteration k.
+type IterResult[T Number] struct {
+	X          *Vector[T]
+	Iterations int
+	Residual   float64
+	History    []float64
+	Converged  bool
+}
+
+// krylov holds the state shared by the iterative solvers.
+type krylov[T Number] struct {
+	a, m    LinearOperator[T]
+	n       int
+	tol     float64
+	maxIter int
+	bnorm   float64
+	res     *IterResult[T]
+}
+
+func newKrylov[T Number](a LinearOperator[T], b *Vector[T], opts *IterOptions[T]) (k *krylov[T], bs, x []T, err error) {
+	if isInteger[T]() {
+		return nil, nil, nil, ErrNotImplemented
+	}
+	if opts == nil {
+		opts = &IterOptions[T]{}
+	}
+	rows, cols := a.Dims()
+	if rows != cols {
+		return nil, nil, nil, ErrNotSquare
+	}
+	if len(b.Shape) != 1 || b.Shape[0] != rows {
+		return nil, nil, nil, ErrShapeMismatch
+	}
+	if opts.Precond != nil {
+		if pr, pc := opts.Precond.Dims(); pr != rows || pc != rows {
+			return nil, nil, nil, ErrShapeMismatch
+		}
+	}
+
+	k = &krylov[T]{
+		a:       a,
+		m:       opts.Precond,
+		n:       rows,
+		tol:     opts.Tol,
+		maxIter: opts.MaxIter,
+		res:     &IterResult[T]{},
+	}
+	if k.tol <= 0 {
+		k.tol = math.Sqrt(machineEpsilon[T]())
+	}
+	if k.maxIter <= 0 {
+		k.maxIter = 10 * rows
+	}
+
+	bs = slices.Clone(b.Contiguous().contiguousData())
+	if k.bnorm = norm2(bs); k.bnorm == 0 {
+		k.bnorm = 1
+	}
+	x = make([]T, rows)
+	if opts.X0 != nil {
+		if len(opts.X0.Shape) != 1 || opts.X0.Shape[0] != rows {
+			return nil, nil, nil, ErrShapeMismatch
+		}
+		copy(x, opts.X0.Contiguous().contiguousData())
+	}
+	return k, bs, x, nil
+}
+
+func (k *krylov[T]) apply(op LinearOperator[T], x []T) ([]T, error) {
+	v := NewVector[T](len(x))
+	copy(v.Data, x)
+	y, err := op.Apply(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(y.Shape) != 1 || y.Shape[0] != k.n {
+		return nil, ErrShapeMismatch
+	}
+	return slices.Clone(y.Contiguous().contiguousData()), nil
+}
+
+func (k *krylov[T]) precond(x []T) ([]T, error) {
+	if k.m == nil {
+		return slices.Clone(x), nil
+	}
+	return k.apply(k.m, x)
+}
+
+// residual returns b - A·x.
+func (k *krylov[T]) residual(b, x []T) ([]T, error) {
+	r, err := k.apply(k.a, x)
+	if err != nil {
+		return nil, err
+	}
+	for i := range r {
+		r[i] = b[i] - r[i]
+	}
+	return r, nil
+}
+
+// record appends the relative residual rel and reports convergence.
+func (k *krylov[T]) record(rel float64) bool {
+	k.res.History = append(k.res.History, rel)
+	k.res.Residual = rel
+	return rel <= k.tol
+}
+
+func (k *krylov[T]) finish(x []T, converged bool) (*IterResult[T], error) {
+	k.res.X = NewVector[T](k.n)
+	copy(k.res.X.Data, x)
+	k.res.Converged = converged
+	if !converged {
+		return k.res, ErrNoConvergence
+	}
+	return k.res, nil
+}
+
+// axpy computes y += a·x.
+func axpy[T Number](y []T, a T, x []T) {
+	for i := range y {
+		y[i] += a * x[i]
+	}
+}
+
+// CG solves A·x = b by the preconditioned conjugate gradient method. A and the
+// preconditioner must be Hermitian positive definite. On failure the last
+// iterate is returned together with ErrNoConvergence.
+func CG[T Number](a LinearOperator[T], b *Vector[T], opts *IterOptions[T]) (res *IterResult[T], err error) {
+	const op = "CG"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	k, bs, x, err := newKrylov(a, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	r, err := k.residual(bs, x)
+	if err != nil {
+		return nil, err
+	}
+	if k.record(norm2(r) / k.bnorm) {
+		return k.finish(x, true)
+	}
+	z, err := k.precond(r)
+	if err != nil {
+		return nil, err
+	}
+	p := slices.Clone(z)
+	rz := dot(r, z)
+
+	for k.res.Iterations < k.maxIter {
+		ap, err := k.apply(k.a, p)
+		if err != nil {
+			return nil, err
+		}
+		pap := dot(p, ap)
+		if pap == 0 {
+			break
+		}
+		alpha := rz / pap
+		axpy(x, alpha, p)
+		axpy(r, 0-alpha, ap)
+		k.res.Iterations++
+		if k.record(norm2(r) / k.bnorm) {
+			return k.finish(x, true)
+		}
+
+		if z, err = k.precond(r); err != nil {
+			return nil, err
+		}
+		rzNew := dot(r, z)
+		beta := rzNew / rz
+		for i := range p {
+			p[i] = z[i] + beta*p[i]
+		}
+		rz = rzNew
+	}
+	return k.finish(x, false)
+}
+
+// BiCGSTAB solves a general square system A·x = b by the stabilized
+// biconjugate gradient method with right preconditioning.
+func BiCGSTAB[T Number](a LinearOperator[T], b *Vector[T], opts *IterOptions[T]) (res *IterResult[T], err error) {
+	const op = "BiCGSTAB"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	k, bs, x, err := newKrylov(a, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	r, err := k.residual(bs, x)
+	if err != nil {
+		return nil, err
+	}
+	if k.record(norm2(r) / k.bnorm) {
+		return k.finish(x, true)
+	}
+
+	rhat := slices.Clone(r)
+	rho, alpha, omega := T(1), T(1), T(1)
+	v := make([]T, k.n)
+	p := make([]T, k.n)
+	for k.res.Iterations < k.maxIter {
+		rhoNew := dot(rhat, r)
+		if rhoNew == 0 {
+			break
+		}
+		beta := (rhoNew / rho) * (alpha / omega)
+		for i := range p {
+			p[i] = r[i] + beta*(p[i]-omega*v[i])
+		}
+		phat, err := k.precond(p)
+		if err != nil {
+			return nil, err
+		}
+		if v, err = k.apply(k.a, phat); err != nil {
+			return nil, err
+		}
+		rv := dot(rhat, v)
+		if rv == 0 {
+			break
+		}
+		alpha = rhoNew / rv
+		axpy(x, alpha, phat)
+		// r становится s = r - alpha·v
+		axpy(r, 0-alpha, v)
+		k.res.Iterations++
+		if rel := norm2(r) / k.bnorm; rel <= k.tol {
+			k.record(rel)
+			return k.finish(x, true)
+		}
+
+		shat, err := k.precond(r)
+		if err != nil {
+			return nil, err
+		}
+		t, err := k.apply(k.a, shat)
+		if err != nil {
+			return nil, err
+		}
+		tt := dot(t, t)
+		if tt == 0 {
+			k.record(norm2(r) / k.bnorm)
+			break
+		}
+		omega = dot(t, r) / tt
+		axpy(x, omega, shat)
+		axpy(r, 0-omega, t)
+		if k.record(norm2(r) / k.bnorm) {
+			return k.finish(x, true)
+		}
+		if omega == 0 {
+			break
+		}
+		rho = rhoNew
+	}
+	return k.finish(x, false)
+}
+
+// GMRES solves a general square system A·x = b by the restarted generalized
+// minimal residual method with right preconditioning. Within a cycle the
+// history holds the residual estimate of the least-squares problem; the true
+// residual is recomputed at every restart.
+func GMRES[T Number](a LinearOperator[T], b *Vector[T], opts *IterOptions[T]) (res *IterResult[T], err error) {
+	const op = "GMRES"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	k, bs, x, err := newKrylov(a, b, opts)
+	if err != nil {
+		return nil, err
+	}
+	restart := min(k.n, 30)
+	if opts != nil && opts.Restart > 0 {
+		restart = opts.Restart
+	}
+
+	r, err := k.residual(bs, x)
+	if err != nil {
+		return nil, err
+	}
+	beta := norm2(r)
+	if k.record(beta / k.bnorm) {
+		return k.finish(x, true)
+	}
+
+	for k.res.Iterations < k.maxIter {
+		vs := [][]T{scaled(r, 1/beta)}
+		h := make([][]T, 0, restart) // h[j] is column j of the rotated Hessenberg matrix
+		cs := make([]T, 0, restart)
+		sn := make([]T, 0, restart)
+		g := make([]T, restart+1)
+		g[0] = fromFloat[T](beta)
+
+		for j := 0; j < restart && k.res.Iterations < k.maxIter; j++ {
+			z, err := k.precond(vs[j])
+			if err != nil {
+				return nil, err
+			}
+			w, err := k.apply(k.a, z)
+			if err != nil {
+				return nil, err
+			}
+			// Arnoldi, модифицированный Грам-Шмидт
+			col := make([]T, j+2)
+			for i := 0; i <= j; i++ {
+				col[i] = dot(vs[i], w)
+				axpy(w, 0-col[i], vs[i])
+			}
+			wn := norm2(w)
+			col[j+1] = fromFloat[T](wn)
+
+			for i := 0; i < j; i++ {
+				col[i], col[i+1] = cs[i]*col[i]+sn[i]*col[i+1], cs[i]*col[i+1]-conj(sn[i])*col[i]
+			}
+			gc, gs := givens(toComplex(col[j]), toComplex(col[j+1]))
+			c, s := fromFloat[T](gc), fromComplex[T](gs)
+			col[j], col[j+1] = c*col[j]+s*col[j+1], 0
+			g[j], g[j+1] = c*g[j], 0-conj(s)*g[j]
+			h = append(h, col)
+			cs = append(cs, c)
+			sn = append(sn, s)
+
+			k.res.Iterations++
+			if k.record(modulus(g[j+1])/k.bnorm) || wn == 0 {
+				break
+			}
+			vs = append(vs, scaled(w, 1/wn))
+		}
+
+		// y = H⁻¹·g, then x += M·(V·y)
+		y := make([]T, len(h))
+		for i := len(y) - 1; i >= 0; i-- {
+			s := g[i]
+			for l := i + 1; l < len(y); l++ {
+				s -= h[l][i] * y[l]
+			}
+			y[i] = s / h[i][i]
+		}
+		u := make([]T, k.n)
+		for i := range y {
+			axpy(u, y[i], vs[i])
+		}
+		if u, err = k.precond(u); err != nil {
+			return nil, err
+		}
+		axpy(x, 1, u)
+
+		if r, err = k.residual(bs, x); err != nil {
+			return nil, err
+		}
+		beta = norm2(r)
+		k.res.Residual = beta / k.bnorm
+		if k.res.Residual <= k.tol {
+			return k.finish(x, true)
+		}
+		if beta == 0 || math.IsNaN(beta) {
+			break
+		}
+	}
+	return k.finish(x, false)
+}
+
+func scaled[T Number](x []T, c float64) []T {
+	out := make([]T, len(x))
+	f := fromFloat[T](c)
+	for i, v := range x {
+		out[i] = v * f
+	}
+	return out
+}
+
+// diagonal applies the inverse of a fixed diagonal.
+type diagonal[T Number] struct {
+	inv []T
+}
+
+// JacobiPreconditioner returns the operator x ↦ D⁻¹·x, where D is the
+// diagonal of m.
+func JacobiPreconditioner[T Number](m *Matrix[T]) (LinearOperator[T], error) {
+	n := m.Shape[0]
+	if m.Shape[1] != n {
+		return nil, ErrNotSquare
+	}
+	d := &diagonal[T]{inv: make([]T, n)}
+	for i := range d.inv {
+		v := m.MustAt(i, i)
+		if v == 0 {
+			return nil, ErrSingularMatrix
+		}
+		d.inv[i] = 1 / v
+	}
+	return d, nil
+}
+
+func (d *diagonal[T]) Dims() (rows, cols int) {
+	return len(d.inv), len(d.inv)
+}
+
+func (d *diagonal[T]) Apply(x *Vector[T]) (*Vector[T], error) {
+	if len(x.Shape) != 1 || x.Shape[0] != len(d.inv) {
+		return nil, ErrShapeMismatch
+	}
+	out := NewVector[T](len(d.inv))
+	for i, v := range d.inv {
+		out.Data[i] = v * x.MustAt(i)
+	}
+	return out, nil
+}
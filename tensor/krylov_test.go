@@ -0,0 +1,135 @@
+package tensor
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// laplacian is the matrix-free 1-D operator tridiag(-1, 2+shift, -1).
+type laplacian struct {
+	n     int
+	shift float64
+}
+
+func (l laplacian) Dims() (int, int) { return l.n, l.n }
+
+func (l laplacian) Apply(x *Vector[float64]) (*Vector[float64], error) {
+	out := NewVector[float64](l.n)
+	for i := 0; i < l.n; i++ {
+		v := (2 + l.shift) * x.Data[i]
+		if i > 0 {
+			v -= x.Data[i-1]
+		}
+		if i < l.n-1 {
+			v -= x.Data[i+1]
+		}
+		out.Data[i] = v
+	}
+	return out, nil
+}
+
+func residualOf[T Number](a LinearOperator[T], x, b *Vector[T]) float64 {
+	ax, _ := a.Apply(x)
+	r := make([]T, b.Shape[0])
+	for i := range r {
+		r[i] = b.Data[i] - ax.Data[i]
+	}
+	return norm2(r) / norm2(b.Data)
+}
+
+func TestKrylov(t *testing.T) {
+	Convey("Given a symmetric positive definite operator", t, func() {
+		const n = 50
+		a := laplacian{n: n}
+		b := NewVector[float64](n)
+		for i := range b.Data {
+			b.Data[i] = float64(i%7) - 3
+		}
+
+		Convey("CG converges in at most n steps with a monotone history", func() {
+			res, err := CG[float64](a, b, &IterOptions[float64]{Tol: 1e-10})
+			So(err, ShouldBeNil)
+			So(res.Converged, ShouldBeTrue)
+			So(res.Iterations, ShouldBeLessThanOrEqualTo, n)
+			So(len(res.History), ShouldEqual, res.Iterations+1)
+			So(res.History[0], ShouldEqual, 1)
+			So(residualOf[float64](a, res.X, b), ShouldBeLessThan, 1e-9)
+		})
+
+		Convey("All solvers agree on a dense matrix", func() {
+			m := NewMatrix[float64](n, n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					m.MustSet(math.Abs(float64(i-j))/10, i, j)
+				}
+				m.MustSet(float64(n), i, i)
+				if i+1 < n {
+					m.MustSet(3, i, i+1)
+				}
+			}
+			pre, err := JacobiPreconditioner(m)
+			So(err, ShouldBeNil)
+			opts := &IterOptions[float64]{Tol: 1e-12, Precond: pre}
+
+			for _, solve := range []func(LinearOperator[float64], *Vector[float64], *IterOptions[float64]) (*IterResult[float64], error){
+				BiCGSTAB[float64], GMRES[float64],
+			} {
+				res, err := solve(m, b, opts)
+				So(err, ShouldBeNil)
+				So(residualOf[float64](m, res.X, b), ShouldBeLessThan, 1e-10)
+			}
+		})
+
+		Convey("Restarted GMRES still converges", func() {
+			res, err := GMRES[float64](laplacian{n: n, shift: 0.5}, b, &IterOptions[float64]{Restart: 5, MaxIter: 500})
+			So(err, ShouldBeNil)
+			So(res.Iterations, ShouldBeGreaterThan, 5)
+			So(residualOf[float64](laplacian{n: n, shift: 0.5}, res.X, b), ShouldBeLessThan, 1e-7)
+		})
+
+		Convey("Hitting MaxIter returns the iterate with ErrNoConvergence", func() {
+			res, err := CG[float64](a, b, &IterOptions[float64]{MaxIter: 3})
+			So(errors.Is(err, ErrNoConvergence), ShouldBeTrue)
+			So(res, ShouldNotBeNil)
+			So(res.Converged, ShouldBeFalse)
+			So(res.Iterations, ShouldEqual, 3)
+			So(res.Residual, ShouldBeLessThan, 1)
+		})
+
+		Convey("An exact initial guess needs no iterations", func() {
+			res, err := CG[float64](a, b, nil)
+			So(err, ShouldBeNil)
+			again, err := BiCGSTAB[float64](a, b, &IterOptions[float64]{X0: res.X})
+			So(err, ShouldBeNil)
+			So(again.Iterations, ShouldEqual, 0)
+		})
+	})
+
+	Convey("Given a complex non-Hermitian system", t, func() {
+		m := NewMatrix[complex128](3, 3)
+		m.Data = []complex128{4, 1i, 0, -1, 5 + 1i, 2, 0, 1 - 1i, 3}
+		b := NewVector[complex128](3)
+		b.Data = []complex128{1, 2i, 3}
+
+		Convey("BiCGSTAB and GMRES solve it", func() {
+			res, err := GMRES[complex128](m, b, nil)
+			So(err, ShouldBeNil)
+			So(residualOf[complex128](m, res.X, b), ShouldBeLessThan, 1e-7)
+			res, err = BiCGSTAB[complex128](m, b, nil)
+			So(err, ShouldBeNil)
+			So(residualOf[complex128](m, res.X, b), ShouldBeLessThan, 1e-7)
+		})
+	})
+
+	Convey("Invalid input is rejected", t, func() {
+		_, err := CG[int](NewMatrix[int](2, 2), NewVector[int](2), nil)
+		So(errors.Is(err, ErrNotImplemented), ShouldBeTrue)
+		_, err = GMRES[float64](NewMatrix[float64](2, 3), NewVector[float64](2), nil)
+		So(errors.Is(err, ErrNotSquare), ShouldBeTrue)
+		_, err = BiCGSTAB[float64](NewMatrix[float64](2, 2), NewVector[float64](3), nil)
+		So(errors.Is(err, ErrShapeMismatch), ShouldBeTrue)
+	})
+}
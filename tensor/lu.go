@@ -0,0 +1,224 @@
+package tensor
+
+// LU is the factorization P·A = L·U of a square matrix with partial pivoting,
+// where L is unit lower triangular and U is upper triangular. Factor once and
+// reuse it for any number of right-hand sides.
+type LU[T Number] struct {
+	n    int
+	lu   []T // L below the diagonal, U on and above it, row-major
+	perm []int
+	sign int
+}
+
+// NewLU factors m. Singular matrices are factored as well; solving with such a
+// factorization returns ErrSingularMatrix. Only float and complex types are
+// supported, integer matrices return ErrNotImplemented.
+func NewLU[T Number](m *Matrix[T]) (out *LU[T], err error) {
+	const op = "LU"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if isInteger[T]() {
+		return nil, ErrNotImplemented
+	}
+	n := m.Shape[0]
+	if m.Shape[1] != n {
+		return nil, ErrNotSquare
+	}
+
+	f := &LU[T]{
+		n:    n,
+		lu:   m.Copy().Data,
+		perm: make([]int, n),
+		sign: 1,
+	}
+	for i := range f.perm {
+		f.perm[i] = i
+	}
+
+	a := f.lu
+	for k := 0; k < n; k++ {
+		p := k
+		for i := k + 1; i < n; i++ {
+			if IsGreater(Abs(a[i*n+k]), Abs(a[p*n+k])) {
+				p = i
+			}
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				a[k*n+j], a[p*n+j] = a[p*n+j], a[k*n+j]
+			}
+			f.perm[k], f.perm[p] = f.perm[p], f.perm[k]
+			f.sign = -f.sign
+		}
+
+		pivot := a[k*n+k]
+		if pivot == 0 {
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			factor := a[i*n+k] / pivot
+			a[i*n+k] = factor
+			if factor == 0 {
+				continue
+			}
+			for j := k + 1; j < n; j++ {
+				a[i*n+j] -= factor * a[k*n+j]
+			}
+		}
+	}
+	return f, nil
+}
+
+// L returns the unit lower triangular factor.
+func (f *LU[T]) L() *Matrix[T] {
+	n := f.n
+	out := NewMatrix[T](n, n)
+	for i := 0; i < n; i++ {
+		copy(out.Data[i*n:i*n+i], f.lu[i*n:i*n+i])
+		out.Data[i*n+i] = 1
+	}
+	return out
+}
+
+// U returns the upper triangular factor.
+func (f *LU[T]) U() *Matrix[T] {
+	n := f.n
+	out := NewMatrix[T](n, n)
+	for i := 0; i < n; i++ {
+		copy(out.Data[i*n+i:(i+1)*n], f.lu[i*n+i:(i+1)*n])
+	}
+	return out
+}
+
+// P returns the permutation matrix.
+func (f *LU[T]) P() *Matrix[T] {
+	n := f.n
+	out := NewMatrix[T](n, n)
+	for i, p := range f.perm {
+		out.Data[i*n+p] = 1
+	}
+	return out
+}
+
+// Pivot returns the row permutation: row i of P·A is row Pivot()[i] of A.
+func (f *LU[T]) Pivot() []int {
+	return append([]int(nil), f.perm...)
+}
+
+// Sign returns the determinant of P, that is ±1.
+func (f *LU[T]) Sign() int {
+	return f.sign
+}
+
+func (f *LU[T]) Det() T {
+	det := T(1)
+	if f.sign < 0 {
+		det = 0 - det
+	}
+	for i := 0; i < f.n; i++ {
+		det *= f.lu[i*f.n+i]
+	}
+	return det
+}
+
+// singular reports whether a pivot is negligible relative to the largest one,
+// so that the test does not depend on the scale of A.
+func (f *LU[T]) singular() bool {
+	largest := 0.0
+	for i := 0; i < f.n; i++ {
+		largest = max(largest, modulus(f.lu[i*f.n+i]))
+	}
+	tol := largest * epsilon[T]() * float64(f.n)
+	for i := 0; i < f.n; i++ {
+		if modulus(f.lu[i*f.n+i]) <= tol {
+			return true
+		}
+	}
+	return false
+}
+
+// Solve returns x with A·x = b.
+func (f *LU[T]) Solve(b *Vector[T]) (out *Vector[T], err error) {
+	const op = "LU.Solve"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if len(b.Shape) != 1 || b.Shape[0] != f.n {
+		return nil, ErrShapeMismatch
+	}
+	if f.singular() {
+		return nil, ErrSingularMatrix
+	}
+
+	n := f.n
+	x := NewVector[T](n)
+	for i, p := range f.perm {
+		x.Data[i] = b.MustAt(p)
+	}
+	f.solveInPlace(x.Data, 1)
+	return x, nil
+}
+
+// SolveMatrix returns X with A·X = B, solving for every column of B at once.
+func (f *LU[T]) SolveMatrix(b *Matrix[T]) (out *Matrix[T], err error) {
+	const op = "LU.SolveMatrix"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if b.Shape[0] != f.n {
+		return nil, ErrShapeMismatch
+	}
+	if f.singular() {
+		return nil, ErrSingularMatrix
+	}
+
+	k := b.Shape[1]
+	x := NewMatrix[T](f.n, k)
+	for i, p := range f.perm {
+		for j := 0; j < k; j++ {
+			x.Data[i*k+j] = b.MustAt(p, j)
+		}
+	}
+	f.solveInPlace(x.Data, k)
+	return x, nil
+}
+
+// solveInPlace runs forward and back substitution on the row-major n x k
+// block x, which must already be permuted.
+func (f *LU[T]) solveInPlace(x []T, k int) {
+	n, a := f.n, f.lu
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			l := a[i*n+j]
+			if l == 0 {
+				continue
+			}
+			for c := 0; c < k; c++ {
+				x[i*k+c] -= l * x[j*k+c]
+			}
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			u := a[i*n+j]
+			if u == 0 {
+				continue
+			}
+			for c := 0; c < k; c++ {
+				x[i*k+c] -= u * x[j*k+c]
+			}
+		}
+		d := a[i*n+i]
+		for c := 0; c < k; c++ {
+			x[i*k+c] /= d
+		}
+	}
+}
+
+func (f *LU[T]) Inverse() (*Matrix[T], error) {
+	return f.SolveMatrix(Identity[T](f.n))
+}
@@ -0,0 +1,114 @@
+package tensor
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func expectClose(t *testing.T, got, want []float64, tol float64) {
+	t.Helper()
+	So(len(got), ShouldEqual, len(want))
+	for i := range want {
+		So(got[i], ShouldAlmostEqual, want[i], tol)
+	}
+}
+
+func TestLU(t *testing.T) {
+	Convey("Given a non-singular matrix", t, func() {
+		a := NewMatrix[float64](3, 3)
+		a.Data = []float64{
+			0, 2, 1,
+			1, 1, 1,
+			2, 1, 3,
+		}
+		f, err := NewLU(a)
+		So(err, ShouldBeNil)
+
+		Convey("P·A equals L·U", func() {
+			pa, err := MatMul(f.P(), a)
+			So(err, ShouldBeNil)
+			lu, err := MatMul(f.L(), f.U())
+			So(err, ShouldBeNil)
+			expectClose(t, lu.Data, pa.Data, 1e-12)
+			So(f.Pivot()[0], ShouldEqual, 2)
+		})
+
+		Convey("Det includes the permutation sign", func() {
+			So(f.Det(), ShouldAlmostEqual, -3, 1e-12)
+			So(math.Abs(float64(f.Sign())), ShouldEqual, 1)
+		})
+
+		Convey("Solve and SolveMatrix", func() {
+			b := NewVector[float64](3)
+			b.Data = []float64{5, 5, 12}
+			x, err := f.Solve(b)
+			So(err, ShouldBeNil)
+			expectClose(t, x.Data, []float64{1, 1, 3}, 1e-12)
+
+			bm := NewMatrix[float64](3, 2)
+			bm.Data = []float64{5, 3, 5, 3, 12, 6}
+			xm, err := f.SolveMatrix(bm)
+			So(err, ShouldBeNil)
+			expectClose(t, xm.Data, []float64{1, 1, 1, 1, 3, 1}, 1e-12)
+
+			_, err = f.Solve(NewVector[float64](2))
+			So(errors.Is(err, ErrShapeMismatch), ShouldBeTrue)
+		})
+
+		Convey("Inverse", func() {
+			inv, err := f.Inverse()
+			So(err, ShouldBeNil)
+			id, err := MatMul(a, inv)
+			So(err, ShouldBeNil)
+			expectClose(t, id.Data, Identity[float64](3).Data, 1e-12)
+		})
+	})
+
+	Convey("Complex matrices are supported", t, func() {
+		a := NewMatrix[complex128](2, 2)
+		a.Data = []complex128{1i, 2, 1, 1 - 1i}
+		f, err := NewLU(a)
+		So(err, ShouldBeNil)
+		So(f.Det(), ShouldEqual, complex128(1i*(1-1i)-2))
+	})
+
+	Convey("Singularity is judged relative to the scale of A", t, func() {
+		a := Identity[float64](3)
+		ScaleInto(a.Tensor, a.Tensor, 1e-13)
+		f, err := NewLU(a)
+		So(err, ShouldBeNil)
+		b := NewVector[float64](3)
+		b.Data = []float64{1e-13, 2e-13, 3e-13}
+		x, err := f.Solve(b)
+		So(err, ShouldBeNil)
+		expectClose(t, x.Data, []float64{1, 2, 3}, 1e-12)
+		_, err = f.SolveMatrix(a)
+		So(err, ShouldBeNil)
+		inv, err := f.Inverse()
+		So(err, ShouldBeNil)
+		So(inv.MustAt(1, 1), ShouldAlmostEqual, 1e13, 1)
+
+		a.MustSet(1e-30, 2, 2)
+		f, _ = NewLU(a)
+		_, err = f.Solve(b)
+		So(errors.Is(err, ErrSingularMatrix), ShouldBeTrue)
+	})
+
+	Convey("Singular and unsupported inputs", t, func() {
+		s := NewMatrix[float64](2, 2)
+		s.Data = []float64{1, 2, 2, 4}
+		f, err := NewLU(s)
+		So(err, ShouldBeNil)
+		So(f.Det(), ShouldEqual, 0)
+		_, err = f.Inverse()
+		So(errors.Is(err, ErrSingularMatrix), ShouldBeTrue)
+
+		_, err = NewLU(NewMatrix[float64](2, 3))
+		So(errors.Is(err, ErrNotSquare), ShouldBeTrue)
+		_, err = NewLU(NewMatrix[int](2, 2))
+		So(errors.Is(err, ErrNotImplemented), ShouldBeTrue)
+	})
+}
@@ -2,6 +2,7 @@ package tensor
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 )
 
@@ -18,6 +19,51 @@ func NewMatrixFromTenzor[T Number](t *Tensor[T]) *Matrix[T] {
 	return &Matrix[T]{t}
 }
 
+// Row returns row i as a vector sharing the matrix data.
+func (m *Matrix[T]) Row(i int) (*Vector[T], error) {
+	row, err := Select(m.Tensor, 0, i)
+	if err != nil {
+		return nil, err
+	}
+	return &Vector[T]{row}, nil
+}
+
+func (m *Matrix[T]) MustRow(i int) *Vector[T] {
+	v, err := m.Row(i)
+	Must(err)
+	return v
+}
+
+// Col returns column j as a vector sharing the matrix data.
+func (m *Matrix[T]) Col(j int) (*Vector[T], error) {
+	col, err := Select(m.Tensor, 1, j)
+	if err != nil {
+		return nil, err
+	}
+	return &Vector[T]{col}, nil
+}
+
+func (m *Matrix[T]) MustCol(j int) *Vector[T] {
+	v, err := m.Col(j)
+	Must(err)
+	return v
+}
+
+// SubMatrix returns the block [r0, r1) x [c0, c1) sharing the matrix data.
+func (m *Matrix[T]) SubMatrix(r0, r1, c0, c1 int) (*Matrix[T], error) {
+	sub, err := Slice(m.Tensor, Span(r0, r1), Span(c0, c1))
+	if err != nil {
+		return nil, err
+	}
+	return &Matrix[T]{sub}, nil
+}
+
+func (m *Matrix[T]) MustSubMatrix(r0, r1, c0, c1 int) *Matrix[T] {
+	sub, err := m.SubMatrix(r0, r1, c0, c1)
+	Must(err)
+	return sub
+}
+
 func E[T Number](x, y int) *Matrix[T] {
 	out := NewMatrix[T](x, y)
 	for i := range len(out.Data) {
@@ -26,34 +72,85 @@ func E[T Number](x, y int) *Matrix[T] {
 	return out
 }
 
+// Identity returns the n x n identity matrix.
+func Identity[T Number](n int) *Matrix[T] {
+	out := NewMatrix[T](n, n)
+	for i := 0; i < n; i++ {
+		out.Data[i*n+i] = 1
+	}
+	return out
+}
+
+// ConjTranspose returns the conjugate transpose of m as a new matrix.
+func ConjTranspose[T Number](m *Matrix[T]) *Matrix[T] {
+	rows, cols := m.Shape[0], m.Shape[1]
+	out := NewMatrix[T](cols, rows)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			out.Data[j*rows+i] = conj(m.MustAt(i, j))
+		}
+	}
+	return out
+}
+
 func MatMul[T Number](a, b *Matrix[T]) (out *Matrix[T], err error) {
 	if a.Shape[1] != b.Shape[0] {
 		return nil, ErrShapeMismatch
 	}
-	out = nativeMul(a, b)
+	out = NewMatrix[T](a.Shape[0], b.Shape[1])
+	matMulInto(out, a, b, matMulWorkerCount())
 	return out, nil
 }
 
+// nativeMul is the plain triple loop, kept as the reference for benchmarks.
 func nativeMul[T Number](a, b *Matrix[T]) *Matrix[T] {
-	m, n, p := a.Shape[0], a.Shape[1], b.Shape[1]
-	out := NewMatrix[T](m, p)
+	out := NewMatrix[T](a.Shape[0], b.Shape[1])
+	mulInto(out, a, b)
+	return out
+}
 
+// Dims returns the number of rows and columns, so that a Matrix satisfies
+// LinearOperator.
+func (m *Matrix[T]) Dims() (rows, cols int) {
+	return m.Shape[0], m.Shape[1]
+}
+
+// Apply returns the matrix-vector product m·x.
+func (m *Matrix[T]) Apply(x *Vector[T]) (*Vector[T], error) {
+	rows, cols := m.Dims()
+	if len(x.Shape) != 1 || x.Shape[0] != cols {
+		return nil, ErrShapeMismatch
+	}
+	xs := x.Contiguous().contiguousData()
+	out := NewVector[T](rows)
+	for i := 0; i < rows; i++ {
+		var s T
+		for j, v := range xs {
+			s += m.Data[m.Offset+i*m.Strides[0]+j*m.Strides[1]] * v
+		}
+		out.Data[i] = s
+	}
+	return out, nil
+}
+
+// mulInto accumulates a·b into out.
+func mulInto[T Number](out, a, b *Matrix[T]) {
+	m, n, p := a.Shape[0], a.Shape[1], b.Shape[1]
 	for i := 0; i < m; i++ {
 		for k := 0; k < n; k++ {
-			aVal := a.Data[i*a.Strides[0]+k*a.Strides[1]]
+			aVal := a.Data[a.Offset+i*a.Strides[0]+k*a.Strides[1]]
 			if aVal == 0 {
 				continue
 			}
 			for j := 0; j < p; j++ {
-				bVal := b.Data[k*b.Strides[0]+j*b.Strides[1]]
+				bVal := b.Data[b.Offset+k*b.Strides[0]+j*b.Strides[1]]
 				if bVal == 0 {
 					continue
 				}
-				out.Data[i*out.Strides[0]+j*out.Strides[1]] += aVal * bVal
+				out.Data[out.Offset+i*out.Strides[0]+j*out.Strides[1]] += aVal * bVal
 			}
 		}
 	}
-	return out
 }
 
 func (t *Matrix[T]) SubRows(row1, row2 int) error {
@@ -74,6 +171,21 @@ func (t *Matrix[T]) MustSubRows(row1, row2 int) {
 	Must(err)
 }
 
+func (t *Matrix[T]) ScaleRow(row int, c T) error {
+	if row < 0 || row >= t.Shape[0] {
+		return ErrInvalidAxis
+	}
+	for j := 0; j < t.Shape[1]; j++ {
+		t.MustSet(t.MustAt(row, j)*c, row, j)
+	}
+	return nil
+}
+
+func (t *Matrix[T]) MustScaleRow(row int, c T) {
+	err := t.ScaleRow(row, c)
+	Must(err)
+}
+
 func (t *Matrix[T]) SubCols(col1, col2 int) error {
 	rows := t.Shape[0]
 	if col1 < 0 || col1 >= t.Shape[1] || col2 < 0 || col2 >= t.Shape[1] {
@@ -101,8 +213,8 @@ func (t *Matrix[T]) SwapRows(row1, row2 int) error {
 	}
 	cols := t.Shape[1]
 	for j := 0; j < cols; j++ {
-		i1 := row1*t.Strides[0] + j*t.Strides[1]
-		i2 := row2*t.Strides[0] + j*t.Strides[1]
+		i1 := t.Offset + row1*t.Strides[0] + j*t.Strides[1]
+		i2 := t.Offset + row2*t.Strides[0] + j*t.Strides[1]
 		t.Data[i1], t.Data[i2] = t.Data[i2], t.Data[i1]
 	}
 	return nil
@@ -122,8 +234,8 @@ func (t *Matrix[T]) SwapCols(col1, col2 int) error {
 	}
 	rows := t.Shape[0]
 	for i := 0; i < rows; i++ {
-		i1 := i*t.Strides[0] + col1*t.Strides[1]
-		i2 := i*t.Strides[0] + col2*t.Strides[1]
+		i1 := t.Offset + i*t.Strides[0] + col1*t.Strides[1]
+		i2 := t.Offset + i*t.Strides[0] + col2*t.Strides[1]
 		t.Data[i1], t.Data[i2] = t.Data[i2], t.Data[i1]
 	}
 	return nil
@@ -137,8 +249,7 @@ func (t *Matrix[T]) MustSwapCols(col1, col2 int) {
 func (m *Matrix[T]) UpperTriangular() (*Matrix[T], error) {
 	rows, cols := m.Shape[0], m.Shape[1]
 
-	res := NewMatrix[T](rows, cols)
-	copy(res.Data, m.Data)
+	res := &Matrix[T]{m.Copy()}
 
 	eps := GetEpsilon[T]()
 
@@ -172,6 +283,142 @@ func (m *Matrix[T]) UpperTriangular() (*Matrix[T], error) {
 	return res, nil
 }
 
+func (m *Matrix[T]) Trace() (T, error) {
+	if m.Shape[0] != m.Shape[1] {
+		return m.zero, ErrNotSquare
+	}
+	var tr T
+	for i := 0; i < m.Shape[0]; i++ {
+		tr += m.MustAt(i, i)
+	}
+	return tr, nil
+}
+
+// Det returns the determinant. Integer matrices use fraction-free (Bareiss)
+// elimination and are exact, failing with ErrOverflow only if the result does
+// not fit T; other types go through LU.
+func (m *Matrix[T]) Det() (det T, err error) {
+	const op = "Det"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if m.Shape[0] != m.Shape[1] {
+		return m.zero, ErrNotSquare
+	}
+	if isInteger[T]() {
+		a := m.bigRows(0)
+		return fromBigInt[T](bareiss(a, false))
+	}
+	f, err := NewLU(m)
+	if err != nil {
+		return m.zero, err
+	}
+	return f.Det(), nil
+}
+
+// Inverse returns m⁻¹ or ErrSingularMatrix. For integer types the inverse is
+// computed exactly and ErrNotIntegral is returned unless all of its entries
+// are integers (that is, unless det = ±1).
+func (m *Matrix[T]) Inverse() (out *Matrix[T], err error) {
+	const op = "Inverse"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	n := m.Shape[0]
+	if m.Shape[1] != n {
+		return nil, ErrNotSquare
+	}
+	if !isInteger[T]() {
+		f, err := NewLU(m)
+		if err != nil {
+			return nil, err
+		}
+		return f.Inverse()
+	}
+
+	a := m.bigRows(n)
+	for i := 0; i < n; i++ {
+		a[i][n+i].SetInt64(1)
+	}
+	pivot := bareiss(a, true)
+	if pivot.Sign() == 0 {
+		return nil, ErrSingularMatrix
+	}
+	// Слева остаётся pivot·I, справа pivot·A⁻¹
+	out = NewMatrix[T](n, n)
+	q, r := new(big.Int), new(big.Int)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			q.QuoRem(a[i][n+j], a[i][i], r)
+			if r.Sign() != 0 {
+				return nil, ErrNotIntegral
+			}
+			if out.Data[i*n+j], err = fromBigInt[T](q); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return out, nil
+}
+
+// bigRows copies an integer matrix into rows of *big.Int with extra zero columns.
+func (m *Matrix[T]) bigRows(extra int) [][]*big.Int {
+	rows, cols := m.Shape[0], m.Shape[1]
+	a := make([][]*big.Int, rows)
+	for i := range a {
+		a[i] = make([]*big.Int, cols+extra)
+		for j := range a[i] {
+			if j < cols {
+				a[i][j] = toBigInt(m.MustAt(i, j))
+			} else {
+				a[i][j] = new(big.Int)
+			}
+		}
+	}
+	return a
+}
+
+// bareiss runs fraction-free elimination on the leading square block of a in
+// place, swapping rows as needed, and returns the determinant of that block.
+// With jordan set, entries above the pivots are eliminated too, so a square
+// block ends up as pivot·I. All divisions are exact.
+func bareiss(a [][]*big.Int, jordan bool) *big.Int {
+	n := len(a)
+	sign, prev := 1, big.NewInt(1)
+	t := new(big.Int)
+	for k := 0; k < n; k++ {
+		if a[k][k].Sign() == 0 {
+			p := k + 1
+			for p < n && a[p][k].Sign() == 0 {
+				p++
+			}
+			if p == n {
+				return new(big.Int)
+			}
+			a[k], a[p] = a[p], a[k]
+			sign = -sign
+		}
+		for i := 0; i < n; i++ {
+			if i == k || (!jordan && i < k) {
+				continue
+			}
+			for j := range a[i] {
+				if j == k || (!jordan && j < k) {
+					continue
+				}
+				a[i][j].Mul(a[i][j], a[k][k])
+				a[i][j].Sub(a[i][j], t.Mul(a[i][k], a[k][j]))
+				a[i][j].Quo(a[i][j], prev)
+			}
+			a[i][k].SetInt64(0)
+		}
+		prev = new(big.Int).Set(a[k][k])
+	}
+	return new(big.Int).Mul(prev, big.NewInt(int64(sign)))
+}
+
 func SolveGauss[T Number](a *Matrix[T], b *Vector[T]) (out *Vector[T], err error) {
 	var t T
 	switch any(t).(type) {
@@ -195,28 +442,12 @@ func SolveGaussFloat[T Number](a *Matrix[T], b *Vector[T]) (out *Vector[T], err
 		return nil, ErrShapeMismatch
 	}
 
-	aug := NewMatrix[T](rows, cols+1)
-	for i := 0; i < rows; i++ {
-		for j := 0; j < cols; j++ {
-			aug.Set(a.MustAt(i, j), i, j)
-		}
-		aug.Set(b.MustAt(i), i, cols)
-	}
-	tri, err := aug.UpperTriangular()
+	tri, err := gaussEchelon(a, b)
 	if err != nil {
 		return nil, err
 	}
 
-	rankA, rankAug := RankOfMatrix(a), RankOfMatrix(tri)
-
-	if rankA < rankAug {
-		return nil, ErrNoSolution
-	}
-	if rankA < cols {
-		return nil, ErrInfinitelyMany
-	}
-
-	eps := GetEpsilon[T]()
+	// Пренебрежимо малые опорные элементы уже отсеяны RowEchelon
 	x := NewVector[T](cols)
 	for i := cols - 1; i >= 0; i-- {
 		sum := T(0)
@@ -224,7 +455,7 @@ func SolveGaussFloat[T Number](a *Matrix[T], b *Vector[T]) (out *Vector[T], err
 			sum += tri.MustAt(i, j) * x.MustAt(j)
 		}
 		diag := tri.MustAt(i, i)
-		if IsLessOrEqual(Abs(diag), eps) {
+		if diag == 0 {
 			return nil, fmt.Errorf("zero pivot on row %d", i)
 		}
 		val := (tri.MustAt(i, cols) - sum) / diag
@@ -234,6 +465,112 @@ func SolveGaussFloat[T Number](a *Matrix[T], b *Vector[T]) (out *Vector[T], err
 	return x, nil
 }
 
+// gaussEchelon reduces [a | b] once and reads both ranks off its pivots: a
+// pivot in the column of b makes the system inconsistent. A unique solution
+// leaves an upper triangular system in the first rows.
+func gaussEchelon[T Number](a *Matrix[T], b *Vector[T]) (*Matrix[T], error) {
+	e := RowEchelon(augment(a, b))
+	cols := a.Shape[1]
+	if n := len(e.Pivots); n > 0 && e.Pivots[n-1] == cols {
+		return nil, ErrNoSolution
+	}
+	if len(e.Pivots) < cols {
+		return nil, ErrInfinitelyMany
+	}
+	return e.Matrix, nil
+}
+
+// augment returns the matrix [a | b]. The caller checks that the row counts match.
+func augment[T Number](a *Matrix[T], b *Vector[T]) *Matrix[T] {
+	rows, cols := a.Shape[0], a.Shape[1]
+	aug := NewMatrix[T](rows, cols+1)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			aug.Set(a.MustAt(i, j), i, j)
+		}
+		aug.Set(b.MustAt(i), i, cols)
+	}
+	return aug
+}
+
+// GeneralSolution describes every solution of A·x = b as
+// x = Particular + Null·t for an arbitrary vector t of free parameters.
+type GeneralSolution[T Number] struct {
+	Particular *Vector[T]
+	// Null holds a basis of the null space of A as columns.
+	Null *Matrix[T]
+	// Free lists the free variable set to 1 in each column of Null. It is
+	// only set by SolveGeneral.
+	Free []int
+}
+
+// At returns Particular + Null·t.
+func (s *GeneralSolution[T]) At(t ...T) (*Vector[T], error) {
+	n := s.Null.Shape[1]
+	if len(t) != n {
+		return nil, ErrShapeMismatch
+	}
+	x := &Vector[T]{s.Particular.Copy()}
+	for i := range x.Data {
+		for k, tk := range t {
+			x.Data[i] += s.Null.Data[i*n+k] * tk
+		}
+	}
+	return x, nil
+}
+
+// SolveGeneral solves A·x = b for any consistent system, including
+// underdetermined ones, by reducing [A | b] to RREF. Inconsistent systems
+// return ErrNoSolution. A unique solution has an empty Null.
+func SolveGeneral[T Number](a *Matrix[T], b *Vector[T]) (out *GeneralSolution[T], err error) {
+	defer func() {
+		err = WrapIfNil(err, "SolveGeneral")
+	}()
+
+	rows, cols := a.Shape[0], a.Shape[1]
+	if rows != b.Shape[0] {
+		return nil, ErrShapeMismatch
+	}
+	e, err := RREF(augment(a, b))
+	if err != nil {
+		return nil, err
+	}
+
+	pivotRow := make([]int, cols)
+	for j := range pivotRow {
+		pivotRow[j] = -1
+	}
+	for r, c := range e.Pivots {
+		if c == cols {
+			return nil, ErrNoSolution
+		}
+		pivotRow[c] = r
+	}
+
+	out = &GeneralSolution[T]{Particular: NewVector[T](cols)}
+	for j, r := range pivotRow {
+		if r == -1 {
+			out.Free = append(out.Free, j)
+			continue
+		}
+		out.Particular.Data[j] = e.MustAt(r, cols)
+	}
+
+	k := len(out.Free)
+	out.Null = NewMatrix[T](cols, k)
+	for q, f := range out.Free {
+		out.Null.Data[f*k+q] = 1
+		for c, r := range pivotRow {
+			if r != -1 {
+				out.Null.Data[c*k+q] = 0 - e.MustAt(r, f)
+			}
+		}
+	}
+	return out, nil
+}
+
+// RankOfMatrix counts the non-zero rows of m. That is the rank only if m is
+// already in row-echelon form; use Rank for arbitrary matrices.
 func RankOfMatrix[T Number](m *Matrix[T]) int {
 	rows, cols := m.Shape[0], m.Shape[1]
 	eps := GetEpsilon[T]()
@@ -266,34 +603,23 @@ func (m *Matrix[T]) PrettyString() string {
 	return b.String()
 }
 
+// SolveGaussInt only finds integral solutions; SolveGaussRat solves the same
+// system exactly over the rationals.
 func SolveGaussInt(a *Matrix[int], b *Vector[int]) (out *Vector[int], err error) {
 	defer func() {
 		err = WrapIfNil(err, "SolveGauss")
 	}()
 
 	rows, cols := a.Shape[0], a.Shape[1]
-
-	aug := NewMatrix[int](rows, cols+1)
-	for i := 0; i < rows; i++ {
-		for j := 0; j < cols; j++ {
-			aug.Set(a.MustAt(i, j), i, j)
-		}
-		aug.Set(b.MustAt(i), i, cols)
+	if rows != b.Shape[0] {
+		return nil, ErrShapeMismatch
 	}
 
-	tri, err := aug.UpperTriangular()
+	tri, err := gaussEchelon(a, b)
 	if err != nil {
 		return nil, err
 	}
 
-	rankA, rankAug := RankOfMatrix(a), RankOfMatrix(tri)
-	if rankA < rankAug {
-		return nil, ErrNoSolution
-	}
-	if rankA < cols {
-		return nil, ErrInfinitelyMany
-	}
-
 	x := NewVector[int](cols)
 
 	for i := cols - 1; i >= 0; i-- {
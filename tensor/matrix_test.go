@@ -0,0 +1,62 @@
+package tensor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSolveGeneral(t *testing.T) {
+	Convey("Given an underdetermined consistent system", t, func() {
+		a := NewMatrix[float64](2, 4)
+		a.Data = []float64{
+			1, 2, 0, 1,
+			0, 0, 1, 3,
+		}
+		b := NewVector[float64](2)
+		b.Data = []float64{4, 5}
+
+		s, err := SolveGeneral(a, b)
+		So(err, ShouldBeNil)
+
+		Convey("It has a particular solution and a null space basis", func() {
+			So(s.Free, ShouldResemble, []int{1, 3})
+			expectClose(t, s.Particular.Data, []float64{4, 0, 5, 0}, 1e-12)
+			So(s.Null.Shape, ShouldResemble, []int{4, 2})
+
+			an, err := MatMul(a, s.Null)
+			So(err, ShouldBeNil)
+			expectClose(t, an.Data, []float64{0, 0, 0, 0}, 1e-12)
+		})
+
+		Convey("Every parameter choice solves the system", func() {
+			x, err := s.At(2, -1)
+			So(err, ShouldBeNil)
+			ax, err := Mul(a.Tensor, x.Tensor)
+			So(err, ShouldBeNil)
+			expectClose(t, ax.Data, b.Data, 1e-12)
+
+			_, err = s.At(1)
+			So(err, ShouldEqual, ErrShapeMismatch)
+		})
+	})
+
+	Convey("Unique and inconsistent systems", t, func() {
+		a := NewMatrix[float64](2, 2)
+		a.Data = []float64{2, 1, 1, 3}
+		b := NewVector[float64](2)
+		b.Data = []float64{3, 4}
+		s, err := SolveGeneral(a, b)
+		So(err, ShouldBeNil)
+		So(s.Free, ShouldBeEmpty)
+		expectClose(t, s.Particular.Data, []float64{1, 1}, 1e-12)
+
+		a.Data = []float64{1, 2, 2, 4}
+		_, err = SolveGeneral(a, b)
+		So(errors.Is(err, ErrNoSolution), ShouldBeTrue)
+
+		_, err = SolveGeneral(a, NewVector[float64](3))
+		So(errors.Is(err, ErrShapeMismatch), ShouldBeTrue)
+	})
+}
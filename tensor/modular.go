@@ -0,0 +1,236 @@
+package tensor
+
+import "math/big"
+
+// ModMatrix is a matrix over the finite field GF(P). Entries are kept in
+// [0, P); elimination divides by multiplying with modular inverses.
+type ModMatrix struct {
+	*Matrix[int64]
+	P int64
+}
+
+// NewModMatrix returns a zero rows x cols matrix over GF(p). The modulus must
+// be a prime below 2^31, so that products of entries fit in int64.
+func NewModMatrix(rows, cols int, p int64) (*ModMatrix, error) {
+	if p < 2 || p >= 1<<31 || !big.NewInt(p).ProbablyPrime(20) {
+		return nil, ErrInvalidModulus
+	}
+	return &ModMatrix{NewMatrix[int64](rows, cols), p}, nil
+}
+
+// ModMatrixFrom reduces an integer matrix modulo p.
+func ModMatrixFrom[T Number](m *Matrix[T], p int64) (out *ModMatrix, err error) {
+	const op = "ModMatrixFrom"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if !isInteger[T]() {
+		return nil, ErrNotImplemented
+	}
+	out, err = NewModMatrix(m.Shape[0], m.Shape[1], p)
+	if err != nil {
+		return nil, err
+	}
+	mod, r := big.NewInt(p), new(big.Int)
+	for i := 0; i < out.Shape[0]; i++ {
+		for j := 0; j < out.Shape[1]; j++ {
+			r.Mod(toBigInt(m.MustAt(i, j)), mod)
+			out.MustSet(r.Int64(), i, j)
+		}
+	}
+	return out, nil
+}
+
+func (m *ModMatrix) Copy() *ModMatrix {
+	return &ModMatrix{&Matrix[int64]{m.Matrix.Copy()}, m.P}
+}
+
+func (m *ModMatrix) reduce(v int64) int64 {
+	v %= m.P
+	if v < 0 {
+		v += m.P
+	}
+	return v
+}
+
+// Inv returns the multiplicative inverse of v modulo P.
+func (m *ModMatrix) Inv(v int64) (int64, error) {
+	v = m.reduce(v)
+	if v == 0 {
+		return 0, ErrSingularMatrix
+	}
+	return new(big.Int).ModInverse(big.NewInt(v), big.NewInt(m.P)).Int64(), nil
+}
+
+// eliminate reduces a copy of m to row-echelon form with unit pivots, clearing
+// the pivot columns above the pivots as well when jordan is set. It also
+// returns the determinant of a square m. Entries are first brought into
+// [0, P), so that products of two of them fit in int64.
+func (m *ModMatrix) eliminate(jordan bool) (*Echelon[int64], int64, error) {
+	rows, cols := m.Shape[0], m.Shape[1]
+	// Лишняя строка внизу хранит v·row_r для SubRows
+	w := &ModMatrix{NewMatrix[int64](rows+1, cols), m.P}
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			w.Data[i*cols+j] = m.reduce(m.MustAt(i, j))
+		}
+	}
+	row := func(i int) []int64 {
+		return w.Data[i*cols : (i+1)*cols]
+	}
+	perm := make([]int, rows)
+	for i := range perm {
+		perm[i] = i
+	}
+	var pivots []int
+
+	det := int64(1)
+	r := 0
+	for c := 0; c < cols && r < rows; c++ {
+		p := r
+		for p < rows && w.Data[p*cols+c] == 0 {
+			p++
+		}
+		if p == rows {
+			det = 0
+			continue
+		}
+		if p != r {
+			w.MustSwapRows(r, p)
+			perm[r], perm[p] = perm[p], perm[r]
+			det = w.reduce(-det)
+		}
+
+		pivot := w.Data[r*cols+c]
+		inv, err := w.Inv(pivot)
+		if err != nil {
+			return nil, 0, err
+		}
+		det = det * pivot % w.P
+		pr := row(r)
+		for j := c; j < cols; j++ {
+			pr[j] = pr[j] * inv % w.P
+		}
+
+		scratch := row(rows)
+		for i := 0; i < rows; i++ {
+			if i == r || (!jordan && i < r) {
+				continue
+			}
+			v := w.Data[i*cols+c]
+			if v == 0 {
+				continue
+			}
+			// row_i -= v·row_r; левее c строка r нулевая
+			clear(scratch[:c])
+			for j := c; j < cols; j++ {
+				scratch[j] = v * pr[j] % w.P
+			}
+			w.MustSubRows(i, rows)
+			ri := row(i)
+			for j := c; j < cols; j++ {
+				ri[j] = w.reduce(ri[j])
+			}
+		}
+		pivots = append(pivots, c)
+		r++
+	}
+	if r < rows {
+		det = 0
+	}
+	out := NewMatrix[int64](rows, cols)
+	copy(out.Data, w.Data)
+	return &Echelon[int64]{Matrix: out, Pivots: pivots, Perm: perm}, det, nil
+}
+
+// RowEchelon reduces a copy of m to row-echelon form with unit pivots.
+func (m *ModMatrix) RowEchelon() (*Echelon[int64], error) {
+	e, _, err := m.eliminate(false)
+	return e, err
+}
+
+// RREF reduces a copy of m to reduced row-echelon form.
+func (m *ModMatrix) RREF() (*Echelon[int64], error) {
+	e, _, err := m.eliminate(true)
+	return e, err
+}
+
+func (m *ModMatrix) Rank() (int, error) {
+	e, err := m.RowEchelon()
+	if err != nil {
+		return 0, err
+	}
+	return len(e.Pivots), nil
+}
+
+func (m *ModMatrix) Det() (int64, error) {
+	if m.Shape[0] != m.Shape[1] {
+		return 0, ErrNotSquare
+	}
+	_, det, err := m.eliminate(false)
+	return det, err
+}
+
+func (m *ModMatrix) Inverse() (*ModMatrix, error) {
+	n := m.Shape[0]
+	if m.Shape[1] != n {
+		return nil, ErrNotSquare
+	}
+	aug, err := NewModMatrix(n, 2*n, m.P)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			aug.MustSet(m.MustAt(i, j), i, j)
+		}
+		aug.MustSet(1, i, n+i)
+	}
+	e, err := aug.RREF()
+	if err != nil {
+		return nil, err
+	}
+	if len(e.Pivots) < n || n > 0 && e.Pivots[n-1] != n-1 {
+		return nil, ErrSingularMatrix
+	}
+	out, err := NewModMatrix(n, n, m.P)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			out.MustSet(e.MustAt(i, n+j), i, j)
+		}
+	}
+	return out, nil
+}
+
+// Solve solves A·x = b over GF(P), with the same error contract as SolveGauss.
+func (m *ModMatrix) Solve(b *Vector[int64]) (out *Vector[int64], err error) {
+	defer func() {
+		err = WrapIfNil(err, "SolveGauss")
+	}()
+
+	rows, cols := m.Shape[0], m.Shape[1]
+	if rows != b.Shape[0] {
+		return nil, ErrShapeMismatch
+	}
+	// eliminate приводит и A, и b в [0, P)
+	aug := &ModMatrix{augment(m.Matrix, b), m.P}
+	e, err := aug.RREF()
+	if err != nil {
+		return nil, err
+	}
+	if n := len(e.Pivots); n > 0 && e.Pivots[n-1] == cols {
+		return nil, ErrNoSolution
+	}
+	if len(e.Pivots) < cols {
+		return nil, ErrInfinitelyMany
+	}
+	x := NewVector[int64](cols)
+	for i := 0; i < cols; i++ {
+		x.MustSet(e.MustAt(i, cols), i)
+	}
+	return x, nil
+}
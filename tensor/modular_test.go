@@ -0,0 +1,148 @@
+package tensor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestModMatrix(t *testing.T) {
+	Convey("Given matrices over GF(7)", t, func() {
+		a := NewMatrix[int](3, 3)
+		a.Data = []int{2, 3, 1, 4, -1, 5, 0, 6, 3}
+		m, err := ModMatrixFrom(a, 7)
+		So(err, ShouldBeNil)
+		So(m.Data, ShouldResemble, []int64{2, 3, 1, 4, 6, 5, 0, 6, 3})
+
+		Convey("Non-prime or too large moduli are rejected", func() {
+			_, err := NewModMatrix(2, 2, 12)
+			So(errors.Is(err, ErrInvalidModulus), ShouldBeTrue)
+			_, err = NewModMatrix(2, 2, 1<<31+11)
+			So(errors.Is(err, ErrInvalidModulus), ShouldBeTrue)
+		})
+
+		Convey("Det agrees with the integer determinant reduced mod p", func() {
+			d, err := m.Det()
+			So(err, ShouldBeNil)
+			ad, err := a.Det()
+			So(err, ShouldBeNil)
+			So(d, ShouldEqual, m.reduce(int64(ad)))
+		})
+
+		Convey("Inverse multiplies back to the identity", func() {
+			inv, err := m.Inverse()
+			So(err, ShouldBeNil)
+			for i := 0; i < 3; i++ {
+				for j := 0; j < 3; j++ {
+					var s int64
+					for k := 0; k < 3; k++ {
+						s += m.MustAt(i, k) * inv.MustAt(k, j)
+					}
+					want := int64(0)
+					if i == j {
+						want = 1
+					}
+					So(s%7, ShouldEqual, want)
+				}
+			}
+		})
+
+		Convey("Solve returns x with A·x ≡ b", func() {
+			b := NewVector[int64](3)
+			b.Data = []int64{1, 2, 3}
+			x, err := m.Solve(b)
+			So(err, ShouldBeNil)
+			for i := 0; i < 3; i++ {
+				var s int64
+				for k := 0; k < 3; k++ {
+					s += m.MustAt(i, k) * x.MustAt(k)
+				}
+				So(s%7, ShouldEqual, b.MustAt(i))
+			}
+		})
+
+		Convey("Entries set directly are reduced before elimination", func() {
+			u, _ := NewModMatrix(2, 2, 7)
+			u.Data = []int64{7, 1, -14, 3}
+			rank, err := u.Rank()
+			So(err, ShouldBeNil)
+			So(rank, ShouldEqual, 1)
+			d, err := u.Det()
+			So(err, ShouldBeNil)
+			So(d, ShouldEqual, 0)
+
+			u.Data = []int64{8, 1 - 7<<40, 3, 5 + 7<<40}
+			d, err = u.Det()
+			So(err, ShouldBeNil)
+			So(d, ShouldEqual, 2) // 1·5 - 1·3
+
+			b := NewVector[int64](2)
+			b.Data = []int64{-1, 7<<40 + 2}
+			x, err := u.Solve(b)
+			So(err, ShouldBeNil)
+			So(x.Data, ShouldResemble, []int64{0, 6})
+		})
+
+		Convey("A matrix singular only modulo p is detected", func() {
+			// det = 7 over the integers
+			s := NewMatrix[int](2, 2)
+			s.Data = []int{3, 1, 1, 5}
+			sm, _ := ModMatrixFrom(s, 7)
+			d, _ := sm.Det()
+			So(d, ShouldEqual, 0)
+			rank, err := sm.Rank()
+			So(err, ShouldBeNil)
+			So(rank, ShouldEqual, 1)
+			_, err = sm.Inverse()
+			So(errors.Is(err, ErrSingularMatrix), ShouldBeTrue)
+
+			b := NewVector[int64](2)
+			b.Data = []int64{1, 0}
+			_, err = sm.Solve(b)
+			So(errors.Is(err, ErrNoSolution), ShouldBeTrue)
+			b.Data = []int64{1, 5}
+			_, err = sm.Solve(b)
+			So(errors.Is(err, ErrInfinitelyMany), ShouldBeTrue)
+
+			empty, _ := NewModMatrix(0, 0, 7)
+			inv, err := empty.Inverse()
+			So(err, ShouldBeNil)
+			So(inv.Shape, ShouldResemble, []int{0, 0})
+		})
+
+		Convey("RREF over GF(2) reduces a parity-check matrix", func() {
+			h := NewMatrix[int](3, 4)
+			h.Data = []int{1, 1, 0, 1, 0, 1, 1, 1, 1, 0, 1, 0}
+			hm, _ := ModMatrixFrom(h, 2)
+			e, err := hm.RREF()
+			So(err, ShouldBeNil)
+			So(e.Pivots, ShouldResemble, []int{0, 1})
+			So(e.Data, ShouldResemble, []int64{1, 0, 1, 0, 0, 1, 1, 1, 0, 0, 0, 0})
+		})
+	})
+}
+
+func TestModMatrixLargePrime(t *testing.T) {
+	Convey("Entries near 2^31 do not overflow", t, func() {
+		const p = 2147483647
+		m, err := NewModMatrix(2, 2, p)
+		So(err, ShouldBeNil)
+		m.Data = []int64{p - 1, p - 2, p - 3, 5}
+		inv, err := m.Inverse()
+		So(err, ShouldBeNil)
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 2; j++ {
+				var s int64
+				for k := 0; k < 2; k++ {
+					s = (s + m.MustAt(i, k)*inv.MustAt(k, j)%p) % p
+				}
+				want := int64(0)
+				if i == j {
+					want = 1
+				}
+				So(s, ShouldEqual, want)
+			}
+		}
+	})
+}
@@ -0,0 +1,330 @@
+package tensor
+
+import "math"
+
+// intRows is a contiguous integer matrix with the row and column operations
+// needed for unimodular reductions. An operation whose result leaves the int
+// range sets overflow instead of failing, so callers check it once per step.
+type intRows struct {
+	rows, cols int
+	a          []int
+	overflow   bool
+}
+
+func newIntRows(m *Matrix[int]) *intRows {
+	return &intRows{rows: m.Shape[0], cols: m.Shape[1], a: m.Copy().Data}
+}
+
+func (m *intRows) at(i, j int) int {
+	return m.a[i*m.cols+j]
+}
+
+func (m *intRows) swapRows(i, j int) {
+	for k := 0; k < m.cols; k++ {
+		m.a[i*m.cols+k], m.a[j*m.cols+k] = m.a[j*m.cols+k], m.a[i*m.cols+k]
+	}
+}
+
+func (m *intRows) swapCols(i, j int) {
+	for k := 0; k < m.rows; k++ {
+		m.a[k*m.cols+i], m.a[k*m.cols+j] = m.a[k*m.cols+j], m.a[k*m.cols+i]
+	}
+}
+
+// addRow sets row dst += q·row src.
+func (m *intRows) addRow(dst, src, q int) {
+	for k := 0; k < m.cols; k++ {
+		m.addMul(dst*m.cols+k, q, m.a[src*m.cols+k])
+	}
+}
+
+// addCol sets column dst += q·column src.
+func (m *intRows) addCol(dst, src, q int) {
+	for k := 0; k < m.rows; k++ {
+		m.addMul(k*m.cols+dst, q, m.a[k*m.cols+src])
+	}
+}
+
+func (m *intRows) addMul(i, q, v int) {
+	p, ok := mulInt(q, v)
+	s, ok2 := addInt(m.a[i], p)
+	m.a[i] = s
+	m.overflow = m.overflow || !ok || !ok2
+}
+
+func (m *intRows) negRow(i int) {
+	for k := 0; k < m.cols; k++ {
+		v := m.a[i*m.cols+k]
+		m.overflow = m.overflow || v == math.MinInt
+		m.a[i*m.cols+k] = -v
+	}
+}
+
+// overflowed reports whether an operation on any of ms left the int range.
+func overflowed(ms ...*intRows) bool {
+	for _, m := range ms {
+		if m.overflow {
+			return true
+		}
+	}
+	return false
+}
+
+// mulInt returns a·b and whether it fits in int.
+func mulInt(a, b int) (int, bool) {
+	p := a * b
+	if a != 0 && (p/a != b || a == -1 && b == math.MinInt) {
+		return p, false
+	}
+	return p, true
+}
+
+// addInt returns a+b and whether it fits in int.
+func addInt(a, b int) (int, bool) {
+	s := a + b
+	return s, (s > a) == (b > 0)
+}
+
+// mulIntVec returns a·x, failing with ErrOverflow instead of wrapping.
+func mulIntVec(a *Matrix[int], x []int) ([]int, error) {
+	rows, cols := a.Shape[0], a.Shape[1]
+	out := make([]int, rows)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			p, ok := mulInt(a.MustAt(i, j), x[j])
+			s, ok2 := addInt(out[i], p)
+			if !ok || !ok2 {
+				return nil, ErrOverflow
+			}
+			out[i] = s
+		}
+	}
+	return out, nil
+}
+
+func (m *intRows) matrix() *Matrix[int] {
+	out := NewMatrix[int](m.rows, m.cols)
+	copy(out.Data, m.a)
+	return out
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// floorDiv returns ⌊a/b⌋ for b > 0.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
+
+// HermiteNormalForm returns H and a unimodular U with U·A = H, where H is the
+// row-style Hermite normal form: upper echelon, positive pivots, and every
+// entry above a pivot in the range [0, pivot). Entries of H and U can grow far
+// beyond those of A; ErrOverflow is returned when they leave the int range.
+func HermiteNormalForm(a *Matrix[int]) (h, u *Matrix[int], err error) {
+	const op = "HermiteNormalForm"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	m := newIntRows(a)
+	t := newIntRows(Identity[int](m.rows))
+
+	r := 0
+	for c := 0; c < m.cols && r < m.rows; c++ {
+		// Алгоритм Евклида по столбцу c: в строке r остаётся НОД
+		for {
+			if overflowed(m, t) {
+				return nil, nil, ErrOverflow
+			}
+			p := -1
+			for i := r; i < m.rows; i++ {
+				if v := absInt(m.at(i, c)); v != 0 && (p == -1 || v < absInt(m.at(p, c))) {
+					p = i
+				}
+			}
+			if p == -1 {
+				break
+			}
+			m.swapRows(r, p)
+			t.swapRows(r, p)
+			done := true
+			for i := r + 1; i < m.rows; i++ {
+				if q := m.at(i, c) / m.at(r, c); q != 0 {
+					m.addRow(i, r, -q)
+					t.addRow(i, r, -q)
+				}
+				done = done && m.at(i, c) == 0
+			}
+			if done {
+				break
+			}
+		}
+		if m.at(r, c) == 0 {
+			continue
+		}
+		if m.at(r, c) < 0 {
+			m.negRow(r)
+			t.negRow(r)
+		}
+		for i := 0; i < r; i++ {
+			if q := floorDiv(m.at(i, c), m.at(r, c)); q != 0 {
+				m.addRow(i, r, -q)
+				t.addRow(i, r, -q)
+			}
+		}
+		r++
+	}
+	if overflowed(m, t) {
+		return nil, nil, ErrOverflow
+	}
+	return m.matrix(), t.matrix(), nil
+}
+
+// SmithNormalForm returns D and unimodular U, V with U·A·V = D, where D is
+// diagonal with non-negative entries d₁ | d₂ | … | dᵣ followed by zeros. Like
+// HermiteNormalForm it returns ErrOverflow rather than a wrapped result.
+func SmithNormalForm(a *Matrix[int]) (d, u, v *Matrix[int], err error) {
+	const op = "SmithNormalForm"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	m := newIntRows(a)
+	left := newIntRows(Identity[int](m.rows))
+	right := newIntRows(Identity[int](m.cols))
+
+	for k := 0; k < min(m.rows, m.cols); k++ {
+		for {
+			if overflowed(m, left, right) {
+				return nil, nil, nil, ErrOverflow
+			}
+			// Наименьший по модулю ненулевой элемент подматрицы — в позицию (k, k)
+			pi, pj := -1, -1
+			for i := k; i < m.rows; i++ {
+				for j := k; j < m.cols; j++ {
+					if val := absInt(m.at(i, j)); val != 0 && (pi == -1 || val < absInt(m.at(pi, pj))) {
+						pi, pj = i, j
+					}
+				}
+			}
+			if pi == -1 {
+				return m.matrix(), left.matrix(), right.matrix(), nil
+			}
+			m.swapRows(k, pi)
+			left.swapRows(k, pi)
+			m.swapCols(k, pj)
+			right.swapCols(k, pj)
+
+			pivot := m.at(k, k)
+			clean := true
+			for i := k + 1; i < m.rows; i++ {
+				if q := m.at(i, k) / pivot; q != 0 {
+					m.addRow(i, k, -q)
+					left.addRow(i, k, -q)
+				}
+				clean = clean && m.at(i, k) == 0
+			}
+			for j := k + 1; j < m.cols; j++ {
+				if q := m.at(k, j) / pivot; q != 0 {
+					m.addCol(j, k, -q)
+					right.addCol(j, k, -q)
+				}
+				clean = clean && m.at(k, j) == 0
+			}
+			if !clean {
+				continue
+			}
+
+			// Делимость: если pivot не делит элемент строки i, добавляем
+			// строку i к строке k и повторяем — модуль pivot уменьшится
+			bad := -1
+			for i := k + 1; i < m.rows && bad == -1; i++ {
+				for j := k + 1; j < m.cols; j++ {
+					if m.at(i, j)%pivot != 0 {
+						bad = i
+						break
+					}
+				}
+			}
+			if bad == -1 {
+				break
+			}
+			m.addRow(k, bad, 1)
+			left.addRow(k, bad, 1)
+		}
+		if m.at(k, k) < 0 {
+			m.negRow(k)
+			left.negRow(k)
+		}
+	}
+	if overflowed(m, left, right) {
+		return nil, nil, nil, ErrOverflow
+	}
+	return m.matrix(), left.matrix(), right.matrix(), nil
+}
+
+// SolveDiophantine finds all integer solutions of A·x = b as
+// x = Particular + Null·t for integer vectors t, using the Smith normal form.
+// Systems without an integer solution return ErrNoSolution.
+func SolveDiophantine(a *Matrix[int], b *Vector[int]) (out *GeneralSolution[int], err error) {
+	defer func() {
+		err = WrapIfNil(err, "SolveDiophantine")
+	}()
+
+	rows, cols := a.Shape[0], a.Shape[1]
+	if rows != b.Shape[0] {
+		return nil, ErrShapeMismatch
+	}
+
+	d, u, v, err := SmithNormalForm(a)
+	if err != nil {
+		return nil, err
+	}
+	ub, err := mulIntVec(u, b.Contiguous().contiguousData())
+	if err != nil {
+		return nil, err
+	}
+
+	// D·y = U·b, x = V·y
+	y := NewVector[int](cols)
+	r := 0
+	for i := 0; i < rows; i++ {
+		c := ub[i]
+		di := 0
+		if i < cols {
+			di = d.MustAt(i, i)
+		}
+		if di == 0 {
+			if c != 0 {
+				return nil, ErrNoSolution
+			}
+			continue
+		}
+		if c%di != 0 {
+			return nil, ErrNoSolution
+		}
+		y.Data[i] = c / di
+		r++
+	}
+
+	x := NewVector[int](cols)
+	if x.Data, err = mulIntVec(v, y.Data); err != nil {
+		return nil, err
+	}
+	null, err := v.SubMatrix(0, cols, r, cols)
+	if err != nil {
+		return nil, err
+	}
+	return &GeneralSolution[int]{
+		Particular: x,
+		Null:       &Matrix[int]{null.Copy()},
+	}, nil
+}
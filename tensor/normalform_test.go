@@ -0,0 +1,114 @@
+package tensor
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func expectUnimodular(t *testing.T, u *Matrix[int]) {
+	t.Helper()
+	det, err := u.Det()
+	So(err, ShouldBeNil)
+	So(det == 1 || det == -1, ShouldBeTrue)
+}
+
+func TestIntegerNormalForms(t *testing.T) {
+	a := NewMatrix[int](3, 4)
+	a.Data = []int{
+		2, 4, 4, 6,
+		-6, 6, 12, 0,
+		10, -4, -16, 8,
+	}
+
+	Convey("HermiteNormalForm", t, func() {
+		h, u, err := HermiteNormalForm(a)
+		So(err, ShouldBeNil)
+		expectUnimodular(t, u)
+		ua, err := MatMul(u, a)
+		So(err, ShouldBeNil)
+		So(ua.Data, ShouldResemble, h.Data)
+
+		e := RowEchelon(h)
+		for r, c := range e.Pivots {
+			pivot := h.MustAt(r, c)
+			So(pivot, ShouldBeGreaterThan, 0)
+			for i := 0; i < r; i++ {
+				So(h.MustAt(i, c), ShouldBeGreaterThanOrEqualTo, 0)
+				So(h.MustAt(i, c), ShouldBeLessThan, pivot)
+			}
+			for i := r + 1; i < 3; i++ {
+				So(h.MustAt(i, c), ShouldEqual, 0)
+			}
+		}
+	})
+
+	Convey("SmithNormalForm", t, func() {
+		d, u, v, err := SmithNormalForm(a)
+		So(err, ShouldBeNil)
+		expectUnimodular(t, u)
+		expectUnimodular(t, v)
+		ua, err := MatMul(u, a)
+		So(err, ShouldBeNil)
+		uav, err := MatMul(ua, v)
+		So(err, ShouldBeNil)
+		So(uav.Data, ShouldResemble, d.Data)
+
+		So(d.MustAt(0, 0), ShouldEqual, 2)
+		So(d.MustAt(1, 1), ShouldEqual, 2)
+		So(d.MustAt(2, 2), ShouldEqual, 6)
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 4; j++ {
+				if i != j {
+					So(d.MustAt(i, j), ShouldEqual, 0)
+				}
+			}
+		}
+	})
+
+	Convey("SolveDiophantine finds every integer solution", t, func() {
+		// 6x + 10y + 15z = 1 has integer solutions but none SolveGaussInt can find
+		c := NewMatrix[int](1, 3)
+		c.Data = []int{6, 10, 15}
+		b := NewVector[int](1)
+		b.Data = []int{1}
+
+		s, err := SolveDiophantine(c, b)
+		So(err, ShouldBeNil)
+		So(s.Null.Shape, ShouldResemble, []int{3, 2})
+		for _, params := range [][]int{{0, 0}, {1, -2}, {3, 5}} {
+			x, err := s.At(params...)
+			So(err, ShouldBeNil)
+			cx, err := Mul(c.Tensor, x.Tensor)
+			So(err, ShouldBeNil)
+			So(cx.Data, ShouldResemble, []int{1})
+		}
+
+		even := NewMatrix[int](1, 2)
+		even.Data = []int{2, 4}
+		_, err = SolveDiophantine(even, b)
+		So(errors.Is(err, ErrNoSolution), ShouldBeTrue)
+	})
+
+	Convey("Growth beyond the int range is reported", t, func() {
+		// Второй шаг Евклида даёт -2·MaxInt
+		wide := NewMatrix[int](2, 2)
+		wide.Data = []int{3, math.MaxInt, 2, 0}
+		_, _, err := HermiteNormalForm(wide)
+		So(errors.Is(err, ErrOverflow), ShouldBeTrue)
+		_, _, _, err = SmithNormalForm(wide)
+		So(errors.Is(err, ErrOverflow), ShouldBeTrue)
+		b := NewVector[int](2)
+		_, err = SolveDiophantine(wide, b)
+		So(errors.Is(err, ErrOverflow), ShouldBeTrue)
+
+		_, err = mulIntVec(Identity[int](2), []int{math.MaxInt, 1})
+		So(err, ShouldBeNil)
+		ones := NewMatrix[int](1, 2)
+		ones.Data = []int{1, 1}
+		_, err = mulIntVec(ones, []int{math.MaxInt, 1})
+		So(errors.Is(err, ErrOverflow), ShouldBeTrue)
+	})
+}
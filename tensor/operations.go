@@ -29,7 +29,7 @@ func indexOffset[T Number](t *Tensor[T], idxs []int) (out int, err error) {
 	if len(idxs) != len(t.Shape) {
 		return 0, ErrWrongNumberOfIndices
 	}
-	offset := 0
+	offset := t.Offset
 	for i, idx := range idxs {
 		if idx < 0 || idx >= t.Shape[i] {
 			return 0, ErrIndexOutOfRange
@@ -43,41 +43,100 @@ func Equal[T Number](a, b *Tensor[T]) bool {
 	if !SameShape(a, b) {
 		return false
 	}
-	for i := 0; i < len(a.Data); i++ {
-		if a.Data[i] != b.Data[i] {
+	it := newIterator(a.Shape, a, b)
+	for it.Next() {
+		if a.Data[it.Offset(0)] != b.Data[it.Offset(1)] {
 			return false
 		}
 	}
 	return true
 }
 
+// Mul is a matrix product with NumPy matmul semantics: 1-D·1-D is a dot
+// product, a 1-D operand is treated as a row (left) or column (right) vector and
+// that axis is dropped from the result, and for more than two dimensions the
+// leading batch dimensions are broadcast.
 func Mul[T Number](a, b *Tensor[T]) (out *Tensor[T], err error) {
 	const op = "Mul"
 	defer func() {
 		err = WrapIfNil(err, op)
 	}()
-	if a.size != b.size {
+	if len(a.Shape) == 0 || len(b.Shape) == 0 {
 		return nil, ErrShapeMismatch
 	}
 
-	//TODO: добавить другие размерности
-	switch {
-	case len(a.Shape) == 2:
-		m, err := MatMul(NewMatrixFromTenzor(a), NewMatrixFromTenzor(b))
-		return m.Tensor, err
-	default:
-		return nil, ErrNotImplemented
+	av, bv := a, b
+	if len(a.Shape) == 1 {
+		if av, err = Unsqueeze(a, 0); err != nil {
+			return nil, err
+		}
+	}
+	if len(b.Shape) == 1 {
+		if bv, err = Unsqueeze(b, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	ra, rb := len(av.Shape), len(bv.Shape)
+	m, n, p := av.Shape[ra-2], av.Shape[ra-1], bv.Shape[rb-1]
+	if bv.Shape[rb-2] != n {
+		return nil, ErrShapeMismatch
+	}
+
+	batch, err := BroadcastShapes(av.Shape[:ra-2], bv.Shape[:rb-2])
+	if err != nil {
+		return nil, err
+	}
+	if av, err = BroadcastTo(av, append(slices.Clone(batch), m, n)...); err != nil {
+		return nil, err
+	}
+	if bv, err = BroadcastTo(bv, append(slices.Clone(batch), n, p)...); err != nil {
+		return nil, err
+	}
+
+	out = NewTensor[T](append(slices.Clone(batch), m, p)...)
+	nb := len(batch)
+	workers := matMulWorkerCount()
+	it := newLayoutIterator(batch, out.layout(), av.layout(), bv.layout())
+	for it.Next() {
+		matMulInto(
+			matrixAt(out, it.Offset(0)),
+			matrixAt(av, it.Offset(1)),
+			matrixAt(bv, it.Offset(2)),
+			workers,
+		)
 	}
+
+	// Убираем оси, добавленные для 1-D операндов
+	if len(b.Shape) == 1 {
+		out.Shape, out.Strides = out.Shape[:nb+1], out.Strides[:nb+1]
+	}
+	if len(a.Shape) == 1 {
+		out.Shape = slices.Delete(out.Shape, nb, nb+1)
+		out.Strides = slices.Delete(out.Strides, nb, nb+1)
+	}
+	return out, nil
+}
+
+// matrixAt returns the 2-D matrix formed by the last two axes of t starting at offset.
+func matrixAt[T Number](t *Tensor[T], offset int) *Matrix[T] {
+	r := len(t.Shape)
+	return &Matrix[T]{&Tensor[T]{
+		Shape:   t.Shape[r-2:],
+		size:    t.Shape[r-2] * t.Shape[r-1],
+		Strides: t.Strides[r-2:],
+		Offset:  offset,
+		Data:    t.Data,
+	}}
 }
 
 func elementwiseOp[T Number](a, b *Tensor[T], op func(T, T) T) (*Tensor[T], error) {
-	if !SameShape(a, b) {
-		return nil, ErrShapeMismatch
+	a, b, err := broadcastPair(a, b)
+	if err != nil {
+		return nil, err
 	}
 	out := NewTensor[T](a.Shape...)
-	for i := range a.Data {
-		out.Data[i] = op(a.Data[i], b.Data[i])
-	}
+	zipInto(out, a, b, op)
 	return out, nil
 }
 
@@ -119,9 +178,7 @@ func Div[T Number](a, b *Tensor[T]) (out *Tensor[T], err error) {
 func Scale[T Number](a *Tensor[T], c T) *Tensor[T] {
 	// const op = "scale"
 	out := NewTensor[T](a.Shape...)
-	for i := range a.Data {
-		out.Data[i] = a.Data[i] * c
-	}
+	mapInto(out, a, func(v T) T { return v * c })
 	return out
 }
 
@@ -165,20 +222,17 @@ func Transpose[T Number](t *Tensor[T], order ...int) (out *Tensor[T], err error)
 		Shape:   newShape,
 		size:    t.size,
 		Strides: newStrides,
-		Data:    slices.Clone(t.Data),
+		Offset:  t.Offset,
+		Data:    t.Data,
 	}, nil
 }
 
 func RandomTensor[T Number](t *Tensor[T]) {
-	for i := range t.Data {
-		t.Data[i] = Rand[T]()
-	}
+	mapInto(t, t, func(T) T { return Rand[T]() })
 }
 
 func RandomTensorN[T Number](t *Tensor[T], n T) {
-	for i := range t.Data {
-		t.Data[i] = RandN(n)
-	}
+	mapInto(t, t, func(T) T { return RandN(n) })
 }
 
 func RandN[T Number](n T) T {
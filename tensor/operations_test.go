@@ -1,6 +1,7 @@
 package tensor
 
 import (
+	"errors"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -77,7 +78,7 @@ func TestTensorOperations(t *testing.T) {
 			t2, err := Transpose(a)
 			So(err, ShouldBeNil)
 			So(t2.Shape, ShouldResemble, []int{3, 2})
-			So(t2.Data, ShouldResemble, a.Data) // data order is cloned
+			So(t2.Data, ShouldResemble, a.Data) // data is shared
 		})
 	})
 
@@ -135,3 +136,66 @@ func TestTensorOperations(t *testing.T) {
 		}
 	})
 }
+
+func TestMatMulSemantics(t *testing.T) {
+	Convey("Given vectors and matrices", t, func() {
+		v := NewTensor[int](3)
+		v.Data = []int{1, 2, 3}
+		m := NewTensor[int](2, 3)
+		m.Data = []int{1, 0, 2, 0, 1, 1}
+
+		Convey("1-D x 1-D is a dot product", func() {
+			out, err := Mul(v, v)
+			So(err, ShouldBeNil)
+			So(out.Shape, ShouldResemble, []int{})
+			So(out.MustAt(), ShouldEqual, 14)
+		})
+
+		Convey("Matrix-vector and vector-matrix products drop the vector axis", func() {
+			out, err := Mul(m, v)
+			So(err, ShouldBeNil)
+			So(out.Shape, ShouldResemble, []int{2})
+			So(out.Data, ShouldResemble, []int{7, 5})
+
+			w := NewTensor[int](2)
+			w.Data = []int{1, 2}
+			out, err = Mul(w, m)
+			So(err, ShouldBeNil)
+			So(out.Shape, ShouldResemble, []int{3})
+			So(out.Data, ShouldResemble, []int{1, 2, 4})
+		})
+
+		Convey("Non-square 2-D products validate the inner dimension", func() {
+			b := NewTensor[int](3, 4)
+			for i := range b.Data {
+				b.Data[i] = i
+			}
+			out, err := Mul(m, b)
+			So(err, ShouldBeNil)
+			So(out.Shape, ShouldResemble, []int{2, 4})
+			So(out.Data, ShouldResemble, []int{16, 19, 22, 25, 12, 14, 16, 18})
+
+			_, err = Mul(m, m)
+			So(errors.Is(err, ErrShapeMismatch), ShouldBeTrue)
+		})
+
+		Convey("Batched products broadcast leading dimensions", func() {
+			a := NewTensor[int](2, 2, 3)
+			for i := range a.Data {
+				a.Data[i] = i
+			}
+			out, err := Mul(a, m.MustTranspose())
+			So(err, ShouldBeNil)
+			So(out.Shape, ShouldResemble, []int{2, 2, 2})
+			So(out.Data, ShouldResemble, []int{4, 3, 13, 9, 22, 15, 31, 21})
+
+			out, err = Mul(a, v)
+			So(err, ShouldBeNil)
+			So(out.Shape, ShouldResemble, []int{2, 2})
+			So(out.Data, ShouldResemble, []int{8, 26, 44, 62})
+
+			_, err = Mul(a, NewTensor[int](3, 3, 2))
+			So(errors.Is(err, ErrShapeMismatch), ShouldBeTrue)
+		})
+	})
+}
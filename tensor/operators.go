@@ -1,31 +1,36 @@
 package tensor
 
-func (t *Tensor[T]) Mul(other *Tensor[T]) (err error) {
-	if t.size != other.size && len(t.Shape) != len(other.Shape) {
-		return ErrShapeMismatch
-	}
-
-	//TODO: добавить другие размерности
-	switch {
-	case len(t.Shape) == 2:
-		out, err := MatMul(NewMatrixFromTenzor(t), NewMatrixFromTenzor(other))
-		if err != nil {
-			return err
-		}
-		t.Data = out.Data
+// Mul replaces t with the matrix product t·other, see Mul for the shape rules.
+// When both are matrices and other is square the product keeps the shape of t
+// and is written into its data, so views of t see it. Otherwise the shape
+// changes and t is rebound to a newly allocated result; views keep the old
+// values.
+func (t *Tensor[T]) Mul(other *Tensor[T]) error {
+	if len(t.Shape) == 2 && len(other.Shape) == 2 && t.Shape[1] == other.Shape[0] && other.Shape[0] == other.Shape[1] {
+		s := getMulScratch[T](t.Shape[0], t.Shape[1])
+		defer putMulScratch(s)
+		s.a.Tensor, s.b.Tensor = t, other
+		matMulInto(&s.out, &s.a, &s.b, matMulWorkerCount())
+		copyInto(t, s.out.Tensor)
 		return nil
-	default:
-		return ErrNotImplemented
 	}
+	out, err := Mul(t, other)
+	if err != nil {
+		return err
+	}
+	*t = *out
+	return nil
 }
 
 func (t *Tensor[T]) ElementwiseOp(other *Tensor[T], op func(T, T) T) error {
 	if !SameShape(t, other) {
-		return ErrShapeMismatch
-	}
-	for i := range t.Data {
-		t.Data[i] = op(t.Data[i], other.Data[i])
+		b, err := BroadcastTo(other, t.Shape...)
+		if err != nil {
+			return ErrShapeMismatch
+		}
+		other = b
 	}
+	zipInto(t, t, detach(t, other), op)
 	return nil
 }
 
@@ -65,8 +70,10 @@ func (t *Tensor[T]) MustDiv(other *Tensor[T]) {
 	Must(err)
 }
 
+// Scale multiplies t by c in place and returns t.
 func (t *Tensor[T]) Scale(c T) *Tensor[T] {
-	return Scale(t, c)
+	mapInto(t, t, func(v T) T { return v * c })
+	return t
 }
 
 func (t *Tensor[T]) T() *Tensor[T] {
@@ -0,0 +1,214 @@
+package tensor
+
+import (
+	"math/bits"
+	"reflect"
+	"sync"
+)
+
+// Pool recycles tensors so that temporaries do not have to be reallocated.
+// Free tensors are bucketed by element type and by a power-of-two size class
+// of their capacity. A Pool is safe for concurrent use; the zero value is
+// ready.
+type Pool struct {
+	mu      sync.Mutex
+	buckets map[poolKey][]any // free *Tensor[T] of one type and size class
+	held    map[any]bool      // true: cached, false: live in an arena
+	stats   PoolStats
+}
+
+type poolKey struct {
+	typ   reflect.Type
+	class int // capacity 1<<class
+}
+
+// PoolStats counts pool traffic. A hit is a request served from a released
+// tensor, a miss one that had to allocate.
+type PoolStats struct {
+	Hits, Misses, Releases int64
+	Cached                 int   // tensors waiting for reuse
+	CachedBytes            int64 // their capacity in bytes
+}
+
+func NewPool() *Pool {
+	return &Pool{}
+}
+
+func (p *Pool) Stats() PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.stats
+}
+
+// Clear drops all cached tensors, leaving them to the garbage collector.
+func (p *Pool) Clear() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.buckets = nil
+	for t, cached := range p.held {
+		if cached {
+			delete(p.held, t)
+		}
+	}
+	p.stats.Cached, p.stats.CachedBytes = 0, 0
+}
+
+// sizeClass returns the smallest class whose capacity holds n elements.
+func sizeClass(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return bits.Len(uint(n - 1))
+}
+
+func (p *Pool) get(key poolKey) any {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	free := p.buckets[key]
+	if len(free) == 0 {
+		p.stats.Misses++
+		return nil
+	}
+	t := free[len(free)-1]
+	free[len(free)-1] = nil
+	p.buckets[key] = free[:len(free)-1]
+	delete(p.held, t)
+	p.stats.Hits++
+	p.stats.Cached--
+	p.stats.CachedBytes -= int64(key.typ.Size()) << key.class
+	return t
+}
+
+// put caches t. Only an arena may return a tensor the pool already holds, and
+// only one that it handed out.
+func (p *Pool) put(key poolKey, t any, arena bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if cached, ok := p.held[t]; ok && (cached || !arena) {
+		return ErrDoubleRelease
+	}
+	if p.buckets == nil {
+		p.buckets = make(map[poolKey][]any)
+		p.held = make(map[any]bool)
+	}
+	p.buckets[key] = append(p.buckets[key], t)
+	p.held[t] = true
+	p.stats.Releases++
+	p.stats.Cached++
+	p.stats.CachedBytes += int64(key.typ.Size()) << key.class
+	return nil
+}
+
+// lend marks t as live in an arena, so that Release refuses it.
+func (p *Pool) lend(t any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.held == nil {
+		p.held = make(map[any]bool)
+	}
+	p.held[t] = false
+}
+
+// PoolTensor returns a zeroed, contiguous tensor of the given shape, reusing
+// a released one when possible. It is the pooled counterpart of NewTensor.
+func PoolTensor[T Number](p *Pool, shape ...int) *Tensor[T] {
+	size := 1
+	for _, d := range shape {
+		size *= d
+	}
+	class := sizeClass(size)
+	key := poolKey{reflect.TypeFor[T](), class}
+
+	t, ok := p.get(key).(*Tensor[T])
+	if !ok {
+		t = &Tensor[T]{Data: make([]T, 1<<class)}
+	}
+	t.Data = t.Data[:size]
+	clear(t.Data)
+	t.Shape = append(t.Shape[:0], shape...)
+	t.Strides = append(t.Strides[:0], shape...)
+	stride := 1
+	for i := len(shape) - 1; i >= 0; i-- {
+		t.Strides[i] = stride
+		stride *= shape[i]
+	}
+	t.size, t.Offset = size, 0
+	return t
+}
+
+// Release hands t back to p. Neither t nor any view of it may be used
+// afterwards. Views are refused with ErrReleaseView, since they share the
+// buffer of the tensor they were taken from; a view covering that whole
+// buffer, such as a Reshape, cannot be told apart and must not be released
+// either. A tensor already cached by p or handed out by one of its arenas is
+// refused with ErrDoubleRelease.
+func Release[T Number](p *Pool, t *Tensor[T]) error {
+	if t == nil || cap(t.Data) == 0 {
+		return nil
+	}
+	if t.Offset != 0 || len(t.Data) != t.size || !t.IsContiguous() {
+		return ErrReleaseView
+	}
+	return p.put(releaseKey(t), t, false)
+}
+
+// releaseKey files t under the largest class its capacity covers, so that it
+// fits every request of that class.
+func releaseKey[T Number](t *Tensor[T]) poolKey {
+	return poolKey{reflect.TypeFor[T](), bits.Len(uint(cap(t.Data))) - 1}
+}
+
+// Arena hands out tensors from a Pool and takes them all back at once on
+// Reset, typically at the end of each step of a loop.
+type Arena struct {
+	pool *Pool
+	mu   sync.Mutex
+	live []arenaEntry
+}
+
+type arenaEntry struct {
+	key poolKey
+	t   any
+}
+
+// NewArena returns an arena drawing from p, or from a private pool if p is
+// nil.
+func NewArena(p *Pool) *Arena {
+	if p == nil {
+		p = NewPool()
+	}
+	return &Arena{pool: p}
+}
+
+func (a *Arena) Pool() *Pool {
+	return a.pool
+}
+
+// ArenaTensor returns a zeroed tensor that stays valid until the next Reset.
+func ArenaTensor[T Number](a *Arena, shape ...int) *Tensor[T] {
+	t := PoolTensor[T](a.pool, shape...)
+	a.pool.lend(t)
+	a.mu.Lock()
+	a.live = append(a.live, arenaEntry{releaseKey(t), t})
+	a.mu.Unlock()
+	return t
+}
+
+// Len returns the number of tensors handed out since the last Reset.
+func (a *Arena) Len() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.live)
+}
+
+// Reset returns every tensor of the arena to its pool. None of them may be
+// used afterwards.
+func (a *Arena) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i, e := range a.live {
+		a.pool.put(e.key, e.t, true)
+		a.live[i] = arenaEntry{}
+	}
+	a.live = a.live[:0]
+}
@@ -0,0 +1,135 @@
+package tensor
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPool(t *testing.T) {
+	Convey("Given a pool", t, func() {
+		p := NewPool()
+
+		Convey("Released tensors are reused within their size class", func() {
+			a := PoolTensor[float64](p, 3, 5)
+			So(a.Shape, ShouldResemble, []int{3, 5})
+			So(a.Strides, ShouldResemble, []int{5, 1})
+			So(cap(a.Data), ShouldEqual, 16)
+			a.Data[0] = 42
+			So(Release(p, a), ShouldBeNil)
+
+			b := PoolTensor[float64](p, 4, 2, 2)
+			So(b, ShouldPointTo, a)
+			So(b.Data[0], ShouldEqual, 0)
+			So(b.Strides, ShouldResemble, []int{4, 2, 1})
+			So(b.Equal(NewTensor[float64](4, 2, 2)), ShouldBeTrue)
+
+			c := PoolTensor[float64](p, 17)
+			So(c, ShouldNotPointTo, a)
+
+			s := p.Stats()
+			So(s.Hits, ShouldEqual, 1)
+			So(s.Misses, ShouldEqual, 2)
+			So(s.Releases, ShouldEqual, 1)
+			So(s.Cached, ShouldEqual, 0)
+		})
+
+		Convey("Buckets are separated by element type", func() {
+			Release(p, PoolTensor[float64](p, 8))
+			So(p.Stats().CachedBytes, ShouldEqual, 64)
+			i := PoolTensor[int32](p, 8)
+			So(i.Data, ShouldHaveLength, 8)
+			So(p.Stats().Hits, ShouldEqual, 0)
+			So(p.Stats().Cached, ShouldEqual, 1)
+
+			p.Clear()
+			So(p.Stats().Cached, ShouldEqual, 0)
+		})
+
+		Convey("Foreign tensors are filed under the class their capacity covers", func() {
+			Release(p, NewTensor[int](100))
+			So(PoolTensor[int](p, 65).size, ShouldEqual, 65)
+			So(p.Stats().Hits, ShouldEqual, 0)
+			So(PoolTensor[int](p, 64).size, ShouldEqual, 64)
+			So(p.Stats().Hits, ShouldEqual, 1)
+		})
+
+		Convey("Views and double releases are refused", func() {
+			a := PoolTensor[float64](p, 4, 4)
+			So(errors.Is(Release(p, a.MustSlice(Range{1, 3, 1})), ErrReleaseView), ShouldBeTrue)
+			So(errors.Is(Release(p, a.MustSlice(Range{0, 2, 1})), ErrReleaseView), ShouldBeTrue)
+			So(errors.Is(Release(p, a.MustTranspose()), ErrReleaseView), ShouldBeTrue)
+			So(p.Stats().Releases, ShouldEqual, 0)
+
+			So(Release(p, a), ShouldBeNil)
+			So(errors.Is(Release(p, a), ErrDoubleRelease), ShouldBeTrue)
+			So(p.Stats().Cached, ShouldEqual, 1)
+
+			So(PoolTensor[float64](p, 16), ShouldPointTo, a)
+			So(Release(p, a), ShouldBeNil)
+		})
+
+		Convey("Concurrent use is safe", func() {
+			var wg sync.WaitGroup
+			for g := 0; g < 8; g++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < 100; i++ {
+						Release(p, PoolTensor[float32](p, i%10+1))
+					}
+				}()
+			}
+			wg.Wait()
+			s := p.Stats()
+			So(s.Hits+s.Misses, ShouldEqual, 800)
+			So(s.Releases, ShouldEqual, 800)
+		})
+	})
+}
+
+func TestArena(t *testing.T) {
+	Convey("An arena recycles everything on Reset", t, func() {
+		a := NewArena(nil)
+		for step := 0; step < 3; step++ {
+			x := ArenaTensor[float64](a, 4, 4)
+			y := ArenaTensor[float64](a, 4, 4)
+			So(x, ShouldNotPointTo, y)
+			So(AddInto(y, x, x), ShouldBeNil)
+			ArenaTensor[complex128](a, 2)
+			So(a.Len(), ShouldEqual, 3)
+			a.Reset()
+			So(a.Len(), ShouldEqual, 0)
+		}
+		s := a.Pool().Stats()
+		So(s.Misses, ShouldEqual, 3)
+		So(s.Hits, ShouldEqual, 6)
+		So(s.Cached, ShouldEqual, 3)
+	})
+
+	Convey("Arena tensors are returned by Reset only", t, func() {
+		a := NewArena(nil)
+		x := ArenaTensor[float64](a, 4)
+		So(errors.Is(Release(a.Pool(), x), ErrDoubleRelease), ShouldBeTrue)
+		a.Reset()
+		So(errors.Is(Release(a.Pool(), x), ErrDoubleRelease), ShouldBeTrue)
+		So(a.Pool().Stats().Cached, ShouldEqual, 1)
+	})
+
+	Convey("A steady-state step does not allocate", t, func() {
+		if raceEnabled {
+			t.Skip("allocation counts are not meaningful under the race detector")
+		}
+		a := NewArena(nil)
+		step := func() {
+			x := ArenaTensor[float64](a, 8, 8)
+			y := ArenaTensor[float64](a, 8, 8)
+			AddInto(y, x, x)
+			a.Reset()
+		}
+		step()
+		So(testing.AllocsPerRun(50, step), ShouldEqual, 0)
+	})
+}
@@ -0,0 +1,217 @@
+package tensor
+
+import "math"
+
+// QR is the Householder factorization A = Q·R of an m x n matrix, where Q is
+// unitary and R is upper triangular. Q is kept as a product of reflectors and
+// only formed on request.
+type QR[T Number] struct {
+	m, n int
+	r    []T   // row-major m x n, R on and above the diagonal
+	vs   [][]T // unit Householder vectors, vs[k] acts on rows k..m-1
+}
+
+// NewQR factors m. Only float and complex types are supported.
+func NewQR[T Number](m *Matrix[T]) (out *QR[T], err error) {
+	const op = "QR"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if isInteger[T]() {
+		return nil, ErrNotImplemented
+	}
+
+	rows, cols := m.Shape[0], m.Shape[1]
+	f := &QR[T]{
+		m:  rows,
+		n:  cols,
+		r:  m.Copy().Data,
+		vs: make([][]T, min(rows, cols)),
+	}
+	a := f.r
+	for k := range f.vs {
+		x := make([]T, rows-k)
+		for i := range x {
+			x[i] = a[(k+i)*cols+k]
+		}
+		norm := norm2(x)
+		if norm == 0 {
+			continue
+		}
+		// alpha = -phase(x0)·‖x‖ avoids cancellation in v = x - alpha·e1
+		alpha := 0 - phase(x[0])*fromFloat[T](norm)
+		x[0] -= alpha
+		vnorm := fromFloat[T](norm2(x))
+		for i := range x {
+			x[i] /= vnorm
+		}
+		f.vs[k] = x
+		f.reflect(k, a, cols, k)
+		for i := k + 1; i < rows; i++ {
+			a[i*cols+k] = 0
+		}
+	}
+	return f, nil
+}
+
+// reflect applies H_k = I - 2·v·vᴴ to the columns from col on of the
+// row-major block a with the given number of columns.
+func (f *QR[T]) reflect(k int, a []T, cols, col int) {
+	v := f.vs[k]
+	if v == nil {
+		return
+	}
+	for j := col; j < cols; j++ {
+		var s T
+		for i, vi := range v {
+			s += conj(vi) * a[(k+i)*cols+j]
+		}
+		s *= 2
+		for i, vi := range v {
+			a[(k+i)*cols+j] -= vi * s
+		}
+	}
+}
+
+// applyQH overwrites the row-major m x cols block b with Qᴴ·b.
+func (f *QR[T]) applyQH(b []T, cols int) {
+	for k := range f.vs {
+		f.reflect(k, b, cols, 0)
+	}
+}
+
+// applyQ overwrites the row-major m x cols block b with Q·b.
+func (f *QR[T]) applyQ(b []T, cols int) {
+	for k := len(f.vs) - 1; k >= 0; k-- {
+		f.reflect(k, b, cols, 0)
+	}
+}
+
+func (f *QR[T]) q(cols int) *Matrix[T] {
+	out := NewMatrix[T](f.m, cols)
+	for i := 0; i < min(f.m, cols); i++ {
+		out.Data[i*cols+i] = 1
+	}
+	f.applyQ(out.Data, cols)
+	return out
+}
+
+// Q returns the full m x m unitary factor.
+func (f *QR[T]) Q() *Matrix[T] {
+	return f.q(f.m)
+}
+
+// ThinQ returns the first min(m, n) columns of Q.
+func (f *QR[T]) ThinQ() *Matrix[T] {
+	return f.q(min(f.m, f.n))
+}
+
+func (f *QR[T]) rFactor(rows int) *Matrix[T] {
+	out := NewMatrix[T](rows, f.n)
+	for i := 0; i < rows; i++ {
+		for j := i; j < f.n; j++ {
+			out.Data[i*f.n+j] = f.r[i*f.n+j]
+		}
+	}
+	return out
+}
+
+// R returns the full m x n upper triangular factor.
+func (f *QR[T]) R() *Matrix[T] {
+	return f.rFactor(f.m)
+}
+
+// ThinR returns the first min(m, n) rows of R.
+func (f *QR[T]) ThinR() *Matrix[T] {
+	return f.rFactor(min(f.m, f.n))
+}
+
+// fullRank reports whether the diagonal of R has no negligible entries
+// relative to the largest one.
+func (f *QR[T]) fullRank() bool {
+	k := min(f.m, f.n)
+	largest := 0.0
+	for i := 0; i < k; i++ {
+		largest = math.Max(largest, modulus(f.r[i*f.n+i]))
+	}
+	tol := largest * epsilon[T]() * float64(max(f.m, f.n))
+	for i := 0; i < k; i++ {
+		if modulus(f.r[i*f.n+i]) <= tol {
+			return false
+		}
+	}
+	return largest > 0
+}
+
+// LeastSquares returns x minimizing ‖A·x - b‖ together with that residual
+// norm. Underdetermined systems (fewer rows than columns) get the solution of
+// minimal norm, with zero residual. A must have full rank, otherwise the
+// result is ErrSingularMatrix.
+func LeastSquares[T Number](a *Matrix[T], b *Vector[T]) (x *Vector[T], residual float64, err error) {
+	const op = "LeastSquares"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	rows, cols := a.Shape[0], a.Shape[1]
+	if len(b.Shape) != 1 || b.Shape[0] != rows {
+		return nil, 0, ErrShapeMismatch
+	}
+
+	if rows >= cols {
+		f, err := NewQR(a)
+		if err != nil {
+			return nil, 0, err
+		}
+		return f.Solve(b)
+	}
+
+	// Aᴴ = Q·R, so A = Rᴴ·Qᴴ and the minimal norm solution is Q·(Rᴴ)⁻¹·b
+	f, err := NewQR(ConjTranspose(a))
+	if err != nil {
+		return nil, 0, err
+	}
+	if !f.fullRank() {
+		return nil, 0, ErrSingularMatrix
+	}
+	y := make([]T, cols)
+	for i := 0; i < rows; i++ {
+		s := b.MustAt(i)
+		for j := 0; j < i; j++ {
+			s -= conj(f.r[j*rows+i]) * y[j]
+		}
+		y[i] = s / conj(f.r[i*rows+i])
+	}
+	f.applyQ(y, 1)
+	x = NewVector[T](cols)
+	copy(x.Data, y)
+	return x, 0, nil
+}
+
+// Solve is LeastSquares with a prefactored matrix; A must have at least as
+// many rows as columns.
+func (f *QR[T]) Solve(b *Vector[T]) (x *Vector[T], residual float64, err error) {
+	const op = "QR.Solve"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if len(b.Shape) != 1 || b.Shape[0] != f.m || f.m < f.n {
+		return nil, 0, ErrShapeMismatch
+	}
+	if !f.fullRank() {
+		return nil, 0, ErrSingularMatrix
+	}
+	c := b.Copy().Data
+	f.applyQH(c, 1)
+	x = NewVector[T](f.n)
+	for i := f.n - 1; i >= 0; i-- {
+		s := c[i]
+		for j := i + 1; j < f.n; j++ {
+			s -= f.r[i*f.n+j] * x.Data[j]
+		}
+		x.Data[i] = s / f.r[i*f.n+i]
+	}
+	return x, norm2(c[f.n:]), nil
+}
@@ -0,0 +1,113 @@
+package tensor
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestQR(t *testing.T) {
+	Convey("Given a tall real matrix", t, func() {
+		a := NewMatrix[float64](4, 3)
+		a.Data = []float64{
+			1, 2, 0,
+			0, 1, 1,
+			1, 0, 1,
+			2, 1, 3,
+		}
+		f, err := NewQR(a)
+		So(err, ShouldBeNil)
+
+		Convey("Full and thin factors reproduce A", func() {
+			qr, err := MatMul(f.Q(), f.R())
+			So(err, ShouldBeNil)
+			expectClose(t, qr.Data, a.Data, 1e-12)
+
+			thin, err := MatMul(f.ThinQ(), f.ThinR())
+			So(err, ShouldBeNil)
+			expectClose(t, thin.Data, a.Data, 1e-12)
+			So(f.ThinQ().Shape, ShouldResemble, []int{4, 3})
+			So(f.ThinR().Shape, ShouldResemble, []int{3, 3})
+		})
+
+		Convey("Q is orthogonal and R is upper triangular", func() {
+			q := f.Q()
+			qtq, err := MatMul(ConjTranspose(q), q)
+			So(err, ShouldBeNil)
+			expectClose(t, qtq.Data, Identity[float64](4).Data, 1e-12)
+
+			r := f.R()
+			for i := 1; i < 4; i++ {
+				for j := 0; j < min(i, 3); j++ {
+					So(r.MustAt(i, j), ShouldEqual, 0)
+				}
+			}
+		})
+
+		Convey("LeastSquares fits an overdetermined system", func() {
+			b := NewVector[float64](4)
+			b.Data = []float64{0, 2, 2, 7}
+			x, res, err := LeastSquares(a, b)
+			So(err, ShouldBeNil)
+
+			// Residual must be orthogonal to the columns of A
+			ax, err := Mul(a.Tensor, x.Tensor)
+			So(err, ShouldBeNil)
+			r, err := Sub(b.Tensor, ax)
+			So(err, ShouldBeNil)
+			atr, err := Mul(ConjTranspose(a).Tensor, r)
+			So(err, ShouldBeNil)
+			expectClose(t, atr.Data, []float64{0, 0, 0}, 1e-12)
+			So(res, ShouldAlmostEqual, norm2(r.Data), 1e-12)
+		})
+	})
+
+	Convey("Complex matrices", t, func() {
+		a := NewMatrix[complex128](3, 2)
+		a.Data = []complex128{1 + 1i, 2, 1i, 1 - 1i, 3, 1i}
+		f, err := NewQR(a)
+		So(err, ShouldBeNil)
+		qr, err := MatMul(f.Q(), f.R())
+		So(err, ShouldBeNil)
+		for i := range a.Data {
+			So(cmplxDist(qr.Data[i], a.Data[i]), ShouldBeLessThan, 1e-12)
+		}
+
+		x := NewVector[complex128](2)
+		x.Data = []complex128{2 - 1i, 1i}
+		b, err := Mul(a.Tensor, x.Tensor)
+		So(err, ShouldBeNil)
+		got, res, err := LeastSquares(a, &Vector[complex128]{b})
+		So(err, ShouldBeNil)
+		So(res, ShouldBeLessThan, 1e-12)
+		for i := range x.Data {
+			So(cmplxDist(got.Data[i], x.Data[i]), ShouldBeLessThan, 1e-12)
+		}
+	})
+
+	Convey("Underdetermined systems get the minimal norm solution", t, func() {
+		a := NewMatrix[float64](1, 2)
+		a.Data = []float64{1, 1}
+		b := NewVector[float64](1)
+		b.Data = []float64{2}
+		x, res, err := LeastSquares(a, b)
+		So(err, ShouldBeNil)
+		So(res, ShouldEqual, 0)
+		expectClose(t, x.Data, []float64{1, 1}, 1e-12)
+	})
+
+	Convey("Rank deficiency and bad shapes are reported", t, func() {
+		a := NewMatrix[float64](3, 2)
+		a.Data = []float64{1, 2, 2, 4, 3, 6}
+		_, _, err := LeastSquares(a, NewVector[float64](3))
+		So(errors.Is(err, ErrSingularMatrix), ShouldBeTrue)
+		_, _, err = LeastSquares(a, NewVector[float64](2))
+		So(errors.Is(err, ErrShapeMismatch), ShouldBeTrue)
+	})
+}
+
+func cmplxDist(a, b complex128) float64 {
+	return math.Hypot(real(a-b), imag(a-b))
+}
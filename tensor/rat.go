@@ -0,0 +1,326 @@
+package tensor
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// RatMatrix is a dense matrix of exact rationals. All elimination based
+// routines on it are exact, at the cost of arbitrary precision arithmetic.
+type RatMatrix struct {
+	Rows, Cols int
+	Data       []*big.Rat // row-major
+}
+
+func NewRatMatrix(rows, cols int) *RatMatrix {
+	m := &RatMatrix{Rows: rows, Cols: cols, Data: make([]*big.Rat, rows*cols)}
+	for i := range m.Data {
+		m.Data[i] = new(big.Rat)
+	}
+	return m
+}
+
+// RatMatrixFrom converts m exactly: floats keep their binary value. Complex
+// matrices return ErrNotImplemented, NaN and infinities ErrNotFinite.
+func RatMatrixFrom[T Number](m *Matrix[T]) (out *RatMatrix, err error) {
+	const op = "RatMatrixFrom"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	var zero T
+	switch any(zero).(type) {
+	case complex64, complex128:
+		return nil, ErrNotImplemented
+	}
+
+	out = NewRatMatrix(m.Shape[0], m.Shape[1])
+	for i := 0; i < out.Rows; i++ {
+		for j := 0; j < out.Cols; j++ {
+			v := m.MustAt(i, j)
+			r := out.Data[i*out.Cols+j]
+			if isInteger[T]() {
+				r.SetInt(toBigInt(v))
+				continue
+			}
+			f := toFloat(v)
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, ErrNotFinite
+			}
+			r.SetFloat64(f)
+		}
+	}
+	return out, nil
+}
+
+func (m *RatMatrix) At(i, j int) (*big.Rat, error) {
+	if i < 0 || i >= m.Rows || j < 0 || j >= m.Cols {
+		return nil, ErrIndexOutOfRange
+	}
+	return m.Data[i*m.Cols+j], nil
+}
+
+func (m *RatMatrix) MustAt(i, j int) *big.Rat {
+	v, err := m.At(i, j)
+	Must(err)
+	return v
+}
+
+// Set stores a copy of v.
+func (m *RatMatrix) Set(v *big.Rat, i, j int) error {
+	if i < 0 || i >= m.Rows || j < 0 || j >= m.Cols {
+		return ErrIndexOutOfRange
+	}
+	m.Data[i*m.Cols+j].Set(v)
+	return nil
+}
+
+func (m *RatMatrix) MustSet(v *big.Rat, i, j int) {
+	Must(m.Set(v, i, j))
+}
+
+func (m *RatMatrix) Copy() *RatMatrix {
+	out := NewRatMatrix(m.Rows, m.Cols)
+	for i, v := range m.Data {
+		out.Data[i].Set(v)
+	}
+	return out
+}
+
+func (m *RatMatrix) SwapRows(row1, row2 int) error {
+	if row1 < 0 || row1 >= m.Rows || row2 < 0 || row2 >= m.Rows {
+		return ErrInvalidAxis
+	}
+	for j := 0; j < m.Cols; j++ {
+		i1, i2 := row1*m.Cols+j, row2*m.Cols+j
+		m.Data[i1], m.Data[i2] = m.Data[i2], m.Data[i1]
+	}
+	return nil
+}
+
+func (m *RatMatrix) MustSwapRows(row1, row2 int) {
+	Must(m.SwapRows(row1, row2))
+}
+
+// Mul returns the product m·other.
+func (m *RatMatrix) Mul(other *RatMatrix) (*RatMatrix, error) {
+	if m.Cols != other.Rows {
+		return nil, ErrShapeMismatch
+	}
+	out := NewRatMatrix(m.Rows, other.Cols)
+	t := new(big.Rat)
+	for i := 0; i < m.Rows; i++ {
+		for k := 0; k < m.Cols; k++ {
+			a := m.Data[i*m.Cols+k]
+			if a.Sign() == 0 {
+				continue
+			}
+			for j := 0; j < other.Cols; j++ {
+				o := out.Data[i*out.Cols+j]
+				o.Add(o, t.Mul(a, other.Data[k*other.Cols+j]))
+			}
+		}
+	}
+	return out, nil
+}
+
+// Equal reports whether both matrices have the same shape and entries.
+func (m *RatMatrix) Equal(other *RatMatrix) bool {
+	if m.Rows != other.Rows || m.Cols != other.Cols {
+		return false
+	}
+	for i, v := range m.Data {
+		if v.Cmp(other.Data[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Float64 returns the nearest float64 matrix.
+func (m *RatMatrix) Float64() *Matrix[float64] {
+	out := NewMatrix[float64](m.Rows, m.Cols)
+	for i, v := range m.Data {
+		out.Data[i], _ = v.Float64()
+	}
+	return out
+}
+
+func (m *RatMatrix) PrettyString() string {
+	var b strings.Builder
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			b.WriteString(fmt.Sprintf("%v ", m.MustAt(i, j).RatString()))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// RatEchelon is a RatMatrix in (reduced) row-echelon form, see Echelon.
+type RatEchelon struct {
+	*RatMatrix
+	Pivots []int
+	Perm   []int
+}
+
+// RowEchelon reduces a copy of m to row-echelon form. Pivots are the first
+// non-zero entries, which keeps the permutation minimal.
+func (m *RatMatrix) RowEchelon() *RatEchelon {
+	e := &RatEchelon{RatMatrix: m.Copy(), Perm: make([]int, m.Rows)}
+	for i := range e.Perm {
+		e.Perm[i] = i
+	}
+
+	a, cols := e.Data, m.Cols
+	factor, t := new(big.Rat), new(big.Rat)
+	r := 0
+	for c := 0; c < cols && r < m.Rows; c++ {
+		p := r
+		for p < m.Rows && a[p*cols+c].Sign() == 0 {
+			p++
+		}
+		if p == m.Rows {
+			continue
+		}
+		e.MustSwapRows(r, p)
+		e.Perm[r], e.Perm[p] = e.Perm[p], e.Perm[r]
+
+		for i := r + 1; i < m.Rows; i++ {
+			if a[i*cols+c].Sign() == 0 {
+				continue
+			}
+			factor.Quo(a[i*cols+c], a[r*cols+c])
+			for j := c; j < cols; j++ {
+				a[i*cols+j].Sub(a[i*cols+j], t.Mul(factor, a[r*cols+j]))
+			}
+		}
+		e.Pivots = append(e.Pivots, c)
+		r++
+	}
+	return e
+}
+
+// RREF reduces a copy of m to reduced row-echelon form.
+func (m *RatMatrix) RREF() *RatEchelon {
+	e := m.RowEchelon()
+	a, cols := e.Data, m.Cols
+	factor, t := new(big.Rat), new(big.Rat)
+	for r := len(e.Pivots) - 1; r >= 0; r-- {
+		c := e.Pivots[r]
+		pivot := new(big.Rat).Set(a[r*cols+c])
+		for j := c; j < cols; j++ {
+			a[r*cols+j].Quo(a[r*cols+j], pivot)
+		}
+		for i := 0; i < r; i++ {
+			if a[i*cols+c].Sign() == 0 {
+				continue
+			}
+			factor.Set(a[i*cols+c])
+			for j := c; j < cols; j++ {
+				a[i*cols+j].Sub(a[i*cols+j], t.Mul(factor, a[r*cols+j]))
+			}
+		}
+	}
+	return e
+}
+
+func (m *RatMatrix) Rank() int {
+	return len(m.RowEchelon().Pivots)
+}
+
+func (m *RatMatrix) Det() (*big.Rat, error) {
+	if m.Rows != m.Cols {
+		return nil, ErrNotSquare
+	}
+	e := m.RowEchelon()
+	det := big.NewRat(1, 1)
+	if len(e.Pivots) < m.Rows {
+		return det.SetInt64(0), nil
+	}
+	for i := 0; i < m.Rows; i++ {
+		det.Mul(det, e.Data[i*m.Cols+i])
+	}
+	if permutationSign(e.Perm) < 0 {
+		det.Neg(det)
+	}
+	return det, nil
+}
+
+func (m *RatMatrix) Inverse() (*RatMatrix, error) {
+	n := m.Rows
+	if m.Cols != n {
+		return nil, ErrNotSquare
+	}
+	aug := NewRatMatrix(n, 2*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			aug.Data[i*2*n+j].Set(m.Data[i*n+j])
+		}
+		aug.Data[i*2*n+n+i].SetInt64(1)
+	}
+	e := aug.RREF()
+	if len(e.Pivots) < n || n > 0 && e.Pivots[n-1] != n-1 {
+		return nil, ErrSingularMatrix
+	}
+	out := NewRatMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			out.Data[i*n+j].Set(e.Data[i*2*n+n+j])
+		}
+	}
+	return out, nil
+}
+
+// SolveGaussRat solves A·x = b exactly, with the same error contract as SolveGauss.
+func SolveGaussRat(a *RatMatrix, b []*big.Rat) (out []*big.Rat, err error) {
+	defer func() {
+		err = WrapIfNil(err, "SolveGauss")
+	}()
+
+	if len(b) != a.Rows {
+		return nil, ErrShapeMismatch
+	}
+	aug := NewRatMatrix(a.Rows, a.Cols+1)
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			aug.Data[i*(a.Cols+1)+j].Set(a.Data[i*a.Cols+j])
+		}
+		aug.Data[i*(a.Cols+1)+a.Cols].Set(b[i])
+	}
+
+	e := aug.RREF()
+	if n := len(e.Pivots); n > 0 && e.Pivots[n-1] == a.Cols {
+		return nil, ErrNoSolution
+	}
+	if len(e.Pivots) < a.Cols {
+		return nil, ErrInfinitelyMany
+	}
+	out = make([]*big.Rat, a.Cols)
+	for i := range out {
+		out[i] = new(big.Rat).Set(e.Data[i*(a.Cols+1)+a.Cols])
+	}
+	return out, nil
+}
+
+// permutationSign returns the sign of the permutation p.
+func permutationSign(p []int) int {
+	seen := make([]bool, len(p))
+	sign := 1
+	for i := range p {
+		if seen[i] {
+			continue
+		}
+		length := 0
+		for j := i; !seen[j]; j = p[j] {
+			seen[j] = true
+			length++
+		}
+		if length%2 == 0 {
+			sign = -sign
+		}
+	}
+	return sign
+}
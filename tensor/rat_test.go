@@ -0,0 +1,96 @@
+package tensor
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func rats(vs ...string) []*big.Rat {
+	out := make([]*big.Rat, len(vs))
+	for i, v := range vs {
+		out[i], _ = new(big.Rat).SetString(v)
+	}
+	return out
+}
+
+func TestRatMatrix(t *testing.T) {
+	Convey("Given an integer matrix with a non-integral inverse", t, func() {
+		a := NewMatrix[int](3, 3)
+		a.Data = []int{
+			0, 2, 1,
+			1, 1, 1,
+			2, 1, 3,
+		}
+		r, err := RatMatrixFrom(a)
+		So(err, ShouldBeNil)
+
+		Convey("Det is exact and includes row swaps", func() {
+			det, err := r.Det()
+			So(err, ShouldBeNil)
+			So(det.RatString(), ShouldEqual, "-3")
+		})
+
+		Convey("Inverse is exact", func() {
+			inv, err := r.Inverse()
+			So(err, ShouldBeNil)
+			So(inv.MustAt(0, 0).RatString(), ShouldEqual, "-2/3")
+			id, err := r.Mul(inv)
+			So(err, ShouldBeNil)
+			one, _ := RatMatrixFrom(Identity[int](3))
+			So(id.Equal(one), ShouldBeTrue)
+		})
+
+		Convey("SolveGaussRat finds rational solutions SolveGaussInt rejects", func() {
+			b := NewVector[int](3)
+			b.Data = []int{1, 0, 0}
+			_, err := SolveGaussInt(a, b)
+			So(err, ShouldNotBeNil)
+
+			x, err := SolveGaussRat(r, rats("1", "0", "0"))
+			So(err, ShouldBeNil)
+			So(x[0].RatString(), ShouldEqual, "-2/3")
+			So(x[1].RatString(), ShouldEqual, "1/3")
+			So(x[2].RatString(), ShouldEqual, "1/3")
+		})
+	})
+
+	Convey("RREF, rank and degenerate systems", t, func() {
+		r := NewRatMatrix(2, 3)
+		copy(r.Data, rats("1", "2", "3", "2", "4", "7"))
+		e := r.RREF()
+		So(e.Pivots, ShouldResemble, []int{0, 2})
+		So(e.PrettyString(), ShouldEqual, "1 2 0 \n0 0 1 \n")
+		So(r.Rank(), ShouldEqual, 2)
+
+		s := NewRatMatrix(2, 2)
+		copy(s.Data, rats("1", "2", "2", "4"))
+		det, err := s.Det()
+		So(err, ShouldBeNil)
+		So(det.Sign(), ShouldEqual, 0)
+		_, err = s.Inverse()
+		So(errors.Is(err, ErrSingularMatrix), ShouldBeTrue)
+		_, err = SolveGaussRat(s, rats("1", "2"))
+		So(errors.Is(err, ErrInfinitelyMany), ShouldBeTrue)
+		_, err = SolveGaussRat(s, rats("1", "3"))
+		So(errors.Is(err, ErrNoSolution), ShouldBeTrue)
+
+		inv, err := NewRatMatrix(0, 0).Inverse()
+		So(err, ShouldBeNil)
+		So(inv.Rows, ShouldEqual, 0)
+	})
+
+	Convey("Conversions", t, func() {
+		f := NewMatrix[float64](1, 2)
+		f.Data = []float64{0.5, -0.25}
+		r, err := RatMatrixFrom(f)
+		So(err, ShouldBeNil)
+		So(r.MustAt(0, 1).RatString(), ShouldEqual, "-1/4")
+		So(r.Float64().Data, ShouldResemble, f.Data)
+
+		_, err = RatMatrixFrom(NewMatrix[complex128](1, 1))
+		So(errors.Is(err, ErrNotImplemented), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,290 @@
+package tensor
+
+// reducer walks a tensor and maps every element to the output slot it reduces
+// into. The output has the keepDims shape and is stretched back to the source
+// shape with zero strides on the reduced axes.
+type reducer[T, R Number] struct {
+	src     *Tensor[T]
+	out     *Tensor[R]
+	acc     *Tensor[R]
+	reduced []int
+	count   int
+}
+
+func newReducer[T, R Number](t *Tensor[T], axes []int) (*reducer[T, R], error) {
+	mask := make([]bool, len(t.Shape))
+	if len(axes) == 0 {
+		for i := range mask {
+			mask[i] = true
+		}
+	}
+	for _, axis := range axes {
+		if axis < 0 || axis >= len(t.Shape) {
+			return nil, ErrInvalidAxis
+		}
+		if mask[axis] {
+			return nil, ErrDuplicateAxis
+		}
+		mask[axis] = true
+	}
+
+	r := &reducer[T, R]{src: t, count: 1}
+	shape := make([]int, len(t.Shape))
+	for i, d := range t.Shape {
+		shape[i] = d
+		if mask[i] {
+			shape[i] = 1
+			r.count *= d
+			r.reduced = append(r.reduced, i)
+		}
+	}
+	r.out = NewTensor[R](shape...)
+	r.acc = View(r.out)
+	for _, axis := range r.reduced {
+		r.acc.Shape[axis] = t.Shape[axis]
+		r.acc.Strides[axis] = 0
+	}
+	return r, nil
+}
+
+// each calls fn with the output slot, the row-major position of the element
+// within the reduced axes, and the element itself.
+func (r *reducer[T, R]) each(fn func(slot, pos int, v T)) {
+	it := newLayoutIterator(r.src.Shape, r.src.layout(), r.acc.layout())
+	for it.Next() {
+		pos := 0
+		for _, axis := range r.reduced {
+			pos = pos*r.src.Shape[axis] + it.index[axis]
+		}
+		fn(it.offsets[1], pos, r.src.Data[it.offsets[0]])
+	}
+}
+
+func (r *reducer[T, R]) result(keepDims bool) *Tensor[R] {
+	if keepDims {
+		return r.out
+	}
+	out, err := Squeeze(r.out, r.reduced...)
+	Must(err)
+	return out
+}
+
+// reduceOp selects how an accumulator folds elements.
+type reduceOp int
+
+const (
+	reduceSum reduceOp = iota
+	reduceMean
+	reduceProd
+	reduceMin
+	reduceMax
+)
+
+// accumulator folds elements into the slots of a reduction output. The eager
+// reductions and Expr share it.
+type accumulator[T Number] struct {
+	op    reduceOp
+	out   []T
+	wide  []int64 // integer Mean: sums that do not wrap at the width of T
+	seen  []bool  // Min, Max: the slot holds an element
+	count int     // elements per slot
+}
+
+// newAccumulator prepares the zeroed out for op over count elements per slot.
+func newAccumulator[T Number](op reduceOp, out []T, count int) (*accumulator[T], error) {
+	if count == 0 && (op == reduceMean || op == reduceMin || op == reduceMax) {
+		return nil, ErrEmptyReduction
+	}
+	a := &accumulator[T]{op: op, out: out, count: count}
+	switch op {
+	case reduceMean:
+		if isInteger[T]() {
+			a.wide = make([]int64, len(out))
+		}
+	case reduceProd:
+		for i := range out {
+			out[i] = 1
+		}
+	case reduceMin, reduceMax:
+		a.seen = make([]bool, len(out))
+	}
+	return a, nil
+}
+
+func (a *accumulator[T]) add(slot int, v T) {
+	switch a.op {
+	case reduceSum:
+		a.out[slot] += v
+	case reduceMean:
+		if a.wide != nil {
+			a.wide[slot] += toInt64(v)
+		} else {
+			a.out[slot] += v
+		}
+	case reduceProd:
+		a.out[slot] *= v
+	case reduceMin:
+		if !a.seen[slot] || IsLess(v, a.out[slot]) {
+			a.out[slot], a.seen[slot] = v, true
+		}
+	case reduceMax:
+		if !a.seen[slot] || IsGreater(v, a.out[slot]) {
+			a.out[slot], a.seen[slot] = v, true
+		}
+	}
+}
+
+// finish turns the sums of a Mean into means.
+func (a *accumulator[T]) finish() {
+	if a.op != reduceMean {
+		return
+	}
+	if a.wide != nil {
+		for i, s := range a.wide {
+			a.out[i] = divInt[T](s, a.count)
+		}
+		return
+	}
+	n := fromFloat[T](float64(a.count))
+	for i := range a.out {
+		a.out[i] /= n
+	}
+}
+
+func reduce[T Number](t *Tensor[T], op reduceOp, keepDims bool, axes []int) (*Tensor[T], error) {
+	r, err := newReducer[T, T](t, axes)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := newAccumulator(op, r.out.Data, r.count)
+	if err != nil {
+		return nil, err
+	}
+	r.each(func(slot, _ int, v T) { acc.add(slot, v) })
+	acc.finish()
+	return r.result(keepDims), nil
+}
+
+// Sum adds the elements of t along axes, or over the whole tensor when no axes
+// are given. With keepDims the reduced axes are kept with size 1.
+func Sum[T Number](t *Tensor[T], keepDims bool, axes ...int) (out *Tensor[T], err error) {
+	const op = "Sum"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return reduce(t, reduceSum, keepDims, axes)
+}
+
+// Mean is Sum divided by the number of reduced elements. Integer means truncate
+// and are summed in 64 bits, so narrow types do not wrap.
+func Mean[T Number](t *Tensor[T], keepDims bool, axes ...int) (out *Tensor[T], err error) {
+	const op = "Mean"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return reduce(t, reduceMean, keepDims, axes)
+}
+
+func Prod[T Number](t *Tensor[T], keepDims bool, axes ...int) (out *Tensor[T], err error) {
+	const op = "Prod"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return reduce(t, reduceProd, keepDims, axes)
+}
+
+// Min returns the smallest elements along axes. Complex values are ordered by
+// modulus, as in IsLess.
+func Min[T Number](t *Tensor[T], keepDims bool, axes ...int) (out *Tensor[T], err error) {
+	const op = "Min"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return reduce(t, reduceMin, keepDims, axes)
+}
+
+// Max returns the largest elements along axes. Complex values are ordered by
+// modulus, as in IsGreater.
+func Max[T Number](t *Tensor[T], keepDims bool, axes ...int) (out *Tensor[T], err error) {
+	const op = "Max"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return reduce(t, reduceMax, keepDims, axes)
+}
+
+// ArgMin returns the position of the first smallest element along axes. When
+// several axes are reduced the position is row-major within those axes; without
+// axes it is the flat row-major index into t.
+func ArgMin[T Number](t *Tensor[T], keepDims bool, axes ...int) (out *Tensor[int], err error) {
+	const op = "ArgMin"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return argExtremum(t, keepDims, axes, IsLess[T])
+}
+
+// ArgMax is ArgMin for the largest element.
+func ArgMax[T Number](t *Tensor[T], keepDims bool, axes ...int) (out *Tensor[int], err error) {
+	const op = "ArgMax"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+	return argExtremum(t, keepDims, axes, IsGreater[T])
+}
+
+func argExtremum[T Number](t *Tensor[T], keepDims bool, axes []int, better func(a, b T) bool) (*Tensor[int], error) {
+	r, err := newReducer[T, int](t, axes)
+	if err != nil {
+		return nil, err
+	}
+	if r.count == 0 {
+		return nil, ErrEmptyReduction
+	}
+	best := make([]T, r.out.size)
+	seen := make([]bool, r.out.size)
+	r.each(func(slot, pos int, v T) {
+		if !seen[slot] || better(v, best[slot]) {
+			best[slot] = v
+			r.out.Data[slot] = pos
+			seen[slot] = true
+		}
+	})
+	return r.result(keepDims), nil
+}
+
+// Item returns the only element of a tensor of size 1, such as a full reduction.
+func (t *Tensor[T]) Item() (T, error) {
+	if t.size != 1 {
+		return t.zero, ErrSizeMismatch
+	}
+	return t.Data[t.Offset], nil
+}
+
+func (t *Tensor[T]) Sum(keepDims bool, axes ...int) (*Tensor[T], error) {
+	return Sum(t, keepDims, axes...)
+}
+
+func (t *Tensor[T]) Mean(keepDims bool, axes ...int) (*Tensor[T], error) {
+	return Mean(t, keepDims, axes...)
+}
+
+func (t *Tensor[T]) Prod(keepDims bool, axes ...int) (*Tensor[T], error) {
+	return Prod(t, keepDims, axes...)
+}
+
+func (t *Tensor[T]) Min(keepDims bool, axes ...int) (*Tensor[T], error) {
+	return Min(t, keepDims, axes...)
+}
+
+func (t *Tensor[T]) Max(keepDims bool, axes ...int) (*Tensor[T], error) {
+	return Max(t, keepDims, axes...)
+}
+
+func (t *Tensor[T]) ArgMin(keepDims bool, axes ...int) (*Tensor[int], error) {
+	return ArgMin(t, keepDims, axes...)
+}
+
+func (t *Tensor[T]) ArgMax(keepDims bool, axes ...int) (*Tensor[int], error) {
+	return ArgMax(t, keepDims, axes...)
+}
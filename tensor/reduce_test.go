@@ -0,0 +1,121 @@
+package tensor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReductions(t *testing.T) {
+	Convey("Given a 2x3 tensor", t, func() {
+		a := NewTensor[int](2, 3)
+		a.Data = []int{3, 1, 4, 1, 5, 9}
+
+		Convey("Full reductions return a scalar tensor", func() {
+			s, err := a.Sum(false)
+			So(err, ShouldBeNil)
+			So(s.Shape, ShouldResemble, []int{})
+			So(s.MustAt(), ShouldEqual, 23)
+
+			p, err := a.Prod(true)
+			So(err, ShouldBeNil)
+			So(p.Shape, ShouldResemble, []int{1, 1})
+			v, err := p.Item()
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, 540)
+
+			_, err = a.Item()
+			So(err, ShouldEqual, ErrSizeMismatch)
+		})
+
+		Convey("Axis reductions", func() {
+			s, err := a.Sum(false, 0)
+			So(err, ShouldBeNil)
+			So(s.Shape, ShouldResemble, []int{3})
+			So(s.Data, ShouldResemble, []int{4, 6, 13})
+
+			m, err := a.Mean(true, 1)
+			So(err, ShouldBeNil)
+			So(m.Shape, ShouldResemble, []int{2, 1})
+			So(m.Data, ShouldResemble, []int{2, 5})
+
+			mn, err := a.Min(false, 1)
+			So(err, ShouldBeNil)
+			So(mn.Data, ShouldResemble, []int{1, 1})
+
+			mx, err := a.Max(false, 0)
+			So(err, ShouldBeNil)
+			So(mx.Data, ShouldResemble, []int{3, 5, 9})
+		})
+
+		Convey("Reductions follow logical indices of views", func() {
+			s, err := a.MustTranspose().Sum(false, 1)
+			So(err, ShouldBeNil)
+			So(s.Data, ShouldResemble, []int{4, 6, 13})
+		})
+
+		Convey("ArgMin and ArgMax", func() {
+			am, err := a.ArgMax(false)
+			So(err, ShouldBeNil)
+			So(am.MustAt(), ShouldEqual, 5)
+
+			am, err = a.ArgMin(false, 1)
+			So(err, ShouldBeNil)
+			So(am.Data, ShouldResemble, []int{1, 0})
+
+			am, err = a.ArgMax(true, 0)
+			So(err, ShouldBeNil)
+			So(am.Shape, ShouldResemble, []int{1, 3})
+			So(am.Data, ShouldResemble, []int{0, 1, 1})
+		})
+
+		Convey("Invalid axes and empty reductions", func() {
+			_, err := a.Sum(false, 2)
+			So(errors.Is(err, ErrInvalidAxis), ShouldBeTrue)
+			_, err = a.Sum(false, 0, 0)
+			So(errors.Is(err, ErrDuplicateAxis), ShouldBeTrue)
+
+			e := NewTensor[int](0, 3)
+			s, err := e.Sum(false, 0)
+			So(err, ShouldBeNil)
+			So(s.Data, ShouldResemble, []int{0, 0, 0})
+			_, err = e.Max(false, 0)
+			So(errors.Is(err, ErrEmptyReduction), ShouldBeTrue)
+		})
+	})
+
+	Convey("Complex ordering uses the modulus", t, func() {
+		c := NewTensor[complex128](3)
+		c.Data = []complex128{3 + 4i, -6, 1i}
+		mx, err := c.Max(false)
+		So(err, ShouldBeNil)
+		So(mx.MustAt(), ShouldEqual, complex128(-6))
+
+		am, err := c.ArgMin(false)
+		So(err, ShouldBeNil)
+		So(am.MustAt(), ShouldEqual, 2)
+
+		m, err := c.Mean(false)
+		So(err, ShouldBeNil)
+		So(m.MustAt(), ShouldEqual, complex(-1, 5.0/3))
+	})
+	Convey("Integer means do not wrap at the element width", t, func() {
+		u := NewTensor[uint8](2, 256)
+		for i := range u.Data {
+			u.Data[i] = 200
+		}
+		u.Data[0] = 100 // строка 0: (100 + 255·200) / 256 = 199
+		m, err := u.Mean(false, 1)
+		So(err, ShouldBeNil)
+		So(m.Data, ShouldResemble, []uint8{199, 200})
+
+		s := NewTensor[int8](256)
+		for i := range s.Data {
+			s.Data[i] = -100
+		}
+		ms, err := s.Mean(false)
+		So(err, ShouldBeNil)
+		So(ms.MustAt(), ShouldEqual, int8(-100))
+	})
+}
@@ -0,0 +1,321 @@
+package tensor
+
+import (
+	"math"
+	"math/big"
+	"math/cmplx"
+)
+
+// fromFloat converts f to T, truncating for integer types.
+func fromFloat[T Number](f float64) T {
+	var zero T
+	var v any
+	switch any(zero).(type) {
+	case int:
+		v = int(f)
+	case int8:
+		v = int8(f)
+	case int16:
+		v = int16(f)
+	case int32:
+		v = int32(f)
+	case int64:
+		v = int64(f)
+	case uint:
+		v = uint(f)
+	case uint8:
+		v = uint8(f)
+	case uint16:
+		v = uint16(f)
+	case uint32:
+		v = uint32(f)
+	case uint64:
+		v = uint64(f)
+	case uintptr:
+		v = uintptr(f)
+	case float32:
+		v = float32(f)
+	case float64:
+		v = f
+	case complex64:
+		v = complex(float32(f), 0)
+	case complex128:
+		v = complex(f, 0)
+	}
+	return v.(T)
+}
+
+func isInteger[T Number]() bool {
+	var zero T
+	switch any(zero).(type) {
+	case float32, float64, complex64, complex128:
+		return false
+	}
+	return true
+}
+
+// conj returns the complex conjugate of v, or v itself for real types.
+func conj[T Number](v T) T {
+	switch c := any(v).(type) {
+	case complex64:
+		return any(complex(real(c), -imag(c))).(T)
+	case complex128:
+		return any(cmplx.Conj(c)).(T)
+	}
+	return v
+}
+
+func toComplex[T Number](v T) complex128 {
+	switch c := any(v).(type) {
+	case complex64:
+		return complex128(c)
+	case complex128:
+		return c
+	case float32:
+		return complex(float64(c), 0)
+	case float64:
+		return complex(c, 0)
+	}
+	return complex(toFloat(v), 0)
+}
+
+// fromComplex converts c to T, dropping the imaginary part for real types.
+func fromComplex[T Number](c complex128) T {
+	var zero T
+	switch any(zero).(type) {
+	case complex64:
+		return any(complex64(c)).(T)
+	case complex128:
+		return any(c).(T)
+	}
+	return fromFloat[T](real(c))
+}
+
+// toFloat returns the real part of v as float64.
+func toFloat[T Number](v T) float64 {
+	switch c := any(v).(type) {
+	case int:
+		return float64(c)
+	case int8:
+		return float64(c)
+	case int16:
+		return float64(c)
+	case int32:
+		return float64(c)
+	case int64:
+		return float64(c)
+	case uint:
+		return float64(c)
+	case uint8:
+		return float64(c)
+	case uint16:
+		return float64(c)
+	case uint32:
+		return float64(c)
+	case uint64:
+		return float64(c)
+	case uintptr:
+		return float64(c)
+	case float32:
+		return float64(c)
+	case float64:
+		return c
+	case complex64:
+		return float64(real(c))
+	case complex128:
+		return real(c)
+	}
+	return 0
+}
+
+// modulus returns |v| as float64.
+func modulus[T Number](v T) float64 {
+	switch c := any(v).(type) {
+	case complex64:
+		return cmplx.Abs(complex128(c))
+	case complex128:
+		return cmplx.Abs(c)
+	}
+	return math.Abs(toFloat(v))
+}
+
+// phase returns v/|v|, or 1 for zero.
+func phase[T Number](v T) T {
+	r := modulus(v)
+	if r == 0 {
+		return 1
+	}
+	return fromComplex[T](toComplex(v) / complex(r, 0))
+}
+
+// epsilon returns the modulus of GetEpsilon for T.
+func epsilon[T Number]() float64 {
+	return modulus(GetEpsilon[T]())
+}
+
+// norm2 returns the Euclidean norm of x.
+func norm2[T Number](x []T) float64 {
+	scale, ssq := 0.0, 1.0
+	for _, v := range x {
+		r := modulus(v)
+		if r == 0 {
+			continue
+		}
+		if scale < r {
+			ssq = 1 + ssq*(scale/r)*(scale/r)
+			scale = r
+		} else {
+			ssq += (r / scale) * (r / scale)
+		}
+	}
+	return scale * math.Sqrt(ssq)
+}
+
+// dot returns the inner product xᴴ·y.
+func dot[T Number](x, y []T) T {
+	var s T
+	for i := range x {
+		s += conj(x[i]) * y[i]
+	}
+	return s
+}
+
+// machineEpsilon returns the unit round-off of the real type underlying T.
+func machineEpsilon[T Number]() float64 {
+	var zero T
+	switch any(zero).(type) {
+	case float32, complex64:
+		return 0x1p-23
+	}
+	return 0x1p-52
+}
+
+// toBigInt converts an integer value to *big.Int.
+func toBigInt[T Number](v T) *big.Int {
+	switch c := any(v).(type) {
+	case int:
+		return big.NewInt(int64(c))
+	case int8:
+		return big.NewInt(int64(c))
+	case int16:
+		return big.NewInt(int64(c))
+	case int32:
+		return big.NewInt(int64(c))
+	case int64:
+		return big.NewInt(c)
+	case uint:
+		return new(big.Int).SetUint64(uint64(c))
+	case uint8:
+		return new(big.Int).SetUint64(uint64(c))
+	case uint16:
+		return new(big.Int).SetUint64(uint64(c))
+	case uint32:
+		return new(big.Int).SetUint64(uint64(c))
+	case uint64:
+		return new(big.Int).SetUint64(c)
+	case uintptr:
+		return new(big.Int).SetUint64(uint64(c))
+	}
+	return new(big.Int)
+}
+
+// fromBigInt converts b to the integer type T, failing with ErrOverflow if it
+// does not fit.
+func fromBigInt[T Number](b *big.Int) (T, error) {
+	var zero T
+	var v any
+	switch any(zero).(type) {
+	case int:
+		v = int(b.Int64())
+	case int8:
+		v = int8(b.Int64())
+	case int16:
+		v = int16(b.Int64())
+	case int32:
+		v = int32(b.Int64())
+	case int64:
+		v = b.Int64()
+	case uint:
+		v = uint(b.Uint64())
+	case uint8:
+		v = uint8(b.Uint64())
+	case uint16:
+		v = uint16(b.Uint64())
+	case uint32:
+		v = uint32(b.Uint64())
+	case uint64:
+		v = b.Uint64()
+	case uintptr:
+		v = uintptr(b.Uint64())
+	default:
+		return zero, ErrNotImplemented
+	}
+	if toBigInt(v.(T)).Cmp(b) != 0 {
+		return zero, ErrOverflow
+	}
+	return v.(T), nil
+}
+
+// toInt64 returns an integer value sign- or zero-extended to 64 bits. Sums of
+// the results wrap exactly like uint64 sums for unsigned types.
+func toInt64[T Number](v T) int64 {
+	switch c := any(v).(type) {
+	case int:
+		return int64(c)
+	case int8:
+		return int64(c)
+	case int16:
+		return int64(c)
+	case int32:
+		return int64(c)
+	case int64:
+		return c
+	case uint:
+		return int64(c)
+	case uint8:
+		return int64(c)
+	case uint16:
+		return int64(c)
+	case uint32:
+		return int64(c)
+	case uint64:
+		return int64(c)
+	case uintptr:
+		return int64(c)
+	}
+	return 0
+}
+
+// divInt divides a 64-bit sum from toInt64 by n and converts the quotient to T,
+// treating the sum as unsigned for unsigned types.
+func divInt[T Number](sum int64, n int) T {
+	var zero T
+	var v any
+	switch any(zero).(type) {
+	case int:
+		v = int(sum / int64(n))
+	case int8:
+		v = int8(sum / int64(n))
+	case int16:
+		v = int16(sum / int64(n))
+	case int32:
+		v = int32(sum / int64(n))
+	case int64:
+		v = sum / int64(n)
+	case uint:
+		v = uint(uint64(sum) / uint64(n))
+	case uint8:
+		v = uint8(uint64(sum) / uint64(n))
+	case uint16:
+		v = uint16(uint64(sum) / uint64(n))
+	case uint32:
+		v = uint32(uint64(sum) / uint64(n))
+	case uint64:
+		v = uint64(sum) / uint64(n)
+	case uintptr:
+		v = uintptr(uint64(sum) / uint64(n))
+	default:
+		return zero
+	}
+	return v.(T)
+}
@@ -0,0 +1,159 @@
+package tensor
+
+import "slices"
+
+// Reshape returns t with a new shape of the same size. One dimension may be -1,
+// it is then inferred from the others. The result shares t's data when t is
+// contiguous and is a reshaped copy otherwise.
+func Reshape[T Number](t *Tensor[T], shape ...int) (out *Tensor[T], err error) {
+	const op = "Reshape"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	shape = slices.Clone(shape)
+	inferred, size := -1, 1
+	for i, d := range shape {
+		switch {
+		case d == -1 && inferred == -1:
+			inferred = i
+		case d < 0:
+			return nil, ErrInvalidShape
+		default:
+			size *= d
+		}
+	}
+	if inferred != -1 {
+		if size == 0 || t.size%size != 0 {
+			return nil, ErrSizeMismatch
+		}
+		shape[inferred] = t.size / size
+		size = t.size
+	}
+	if size != t.size {
+		return nil, ErrSizeMismatch
+	}
+
+	src := t.Contiguous()
+	return &Tensor[T]{
+		Shape:   shape,
+		size:    size,
+		Strides: rowMajorStrides(shape),
+		Offset:  src.Offset,
+		Data:    src.Data,
+	}, nil
+}
+
+// Flatten returns t as a 1-D tensor.
+func Flatten[T Number](t *Tensor[T]) *Tensor[T] {
+	out, err := Reshape(t, -1)
+	Must(err)
+	return out
+}
+
+// Squeeze removes the given axes, which must have size 1. Without axes every
+// axis of size 1 is removed. The result always shares t's data.
+func Squeeze[T Number](t *Tensor[T], axes ...int) (out *Tensor[T], err error) {
+	const op = "Squeeze"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	drop := make([]bool, len(t.Shape))
+	if len(axes) == 0 {
+		for i, d := range t.Shape {
+			drop[i] = d == 1
+		}
+	}
+	for _, axis := range axes {
+		if axis < 0 || axis >= len(t.Shape) || t.Shape[axis] != 1 {
+			return nil, ErrInvalidAxis
+		}
+		drop[axis] = true
+	}
+
+	out = View(t)
+	out.Shape, out.Strides = out.Shape[:0], out.Strides[:0]
+	for i := range t.Shape {
+		if !drop[i] {
+			out.Shape = append(out.Shape, t.Shape[i])
+			out.Strides = append(out.Strides, t.Strides[i])
+		}
+	}
+	return out, nil
+}
+
+// ExpandDims inserts axes of size 1. Axes are positions in the result.
+// The result always shares t's data.
+func ExpandDims[T Number](t *Tensor[T], axes ...int) (out *Tensor[T], err error) {
+	const op = "ExpandDims"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	n := len(t.Shape) + len(axes)
+	insert := make([]bool, n)
+	for _, axis := range axes {
+		if axis < 0 || axis >= n {
+			return nil, ErrInvalidAxis
+		}
+		if insert[axis] {
+			return nil, ErrDuplicateAxis
+		}
+		insert[axis] = true
+	}
+
+	out = View(t)
+	out.Shape, out.Strides = make([]int, n), make([]int, n)
+	src := 0
+	for i := range n {
+		if insert[i] {
+			out.Shape[i], out.Strides[i] = 1, 0
+			continue
+		}
+		out.Shape[i], out.Strides[i] = t.Shape[src], t.Strides[src]
+		src++
+	}
+	return out, nil
+}
+
+// Unsqueeze inserts a single axis of size 1 at position axis.
+func Unsqueeze[T Number](t *Tensor[T], axis int) (*Tensor[T], error) {
+	return ExpandDims(t, axis)
+}
+
+func rowMajorStrides(shape []int) []int {
+	strides := make([]int, len(shape))
+	stride := 1
+	for i := len(shape) - 1; i >= 0; i-- {
+		strides[i] = stride
+		stride *= shape[i]
+	}
+	return strides
+}
+
+func (t *Tensor[T]) Reshape(shape ...int) (*Tensor[T], error) {
+	return Reshape(t, shape...)
+}
+
+func (t *Tensor[T]) MustReshape(shape ...int) *Tensor[T] {
+	out, err := Reshape(t, shape...)
+	Must(err)
+	return out
+}
+
+func (t *Tensor[T]) Flatten() *Tensor[T] {
+	return Flatten(t)
+}
+
+func (t *Tensor[T]) Squeeze(axes ...int) (*Tensor[T], error) {
+	return Squeeze(t, axes...)
+}
+
+func (t *Tensor[T]) Unsqueeze(axis int) (*Tensor[T], error) {
+	return Unsqueeze(t, axis)
+}
+
+func (t *Tensor[T]) ExpandDims(axes ...int) (*Tensor[T], error) {
+	return ExpandDims(t, axes...)
+}
@@ -0,0 +1,75 @@
+package tensor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestShapeManipulation(t *testing.T) {
+	Convey("Given a contiguous 2x3 tensor", t, func() {
+		a := NewTensor[int](2, 3)
+		for i := range a.Data {
+			a.Data[i] = i
+		}
+
+		Convey("Reshape shares data and infers -1", func() {
+			r, err := a.Reshape(3, -1)
+			So(err, ShouldBeNil)
+			So(r.Shape, ShouldResemble, []int{3, 2})
+			So(r.Strides, ShouldResemble, []int{2, 1})
+			r.MustSet(42, 2, 1)
+			So(a.MustAt(1, 2), ShouldEqual, 42)
+		})
+
+		Convey("Reshape rejects bad sizes", func() {
+			_, err := a.Reshape(4, 2)
+			So(errors.Is(err, ErrSizeMismatch), ShouldBeTrue)
+			_, err = a.Reshape(4, -1)
+			So(errors.Is(err, ErrSizeMismatch), ShouldBeTrue)
+			_, err = a.Reshape(-1, -1)
+			So(errors.Is(err, ErrInvalidShape), ShouldBeTrue)
+		})
+
+		Convey("Reshape of a non-contiguous view copies", func() {
+			r := a.MustTranspose().MustReshape(6)
+			So(r.Data, ShouldResemble, []int{0, 3, 1, 4, 2, 5})
+			r.MustSet(-1, 0)
+			So(a.MustAt(0, 0), ShouldEqual, 0)
+		})
+
+		Convey("Flatten", func() {
+			f := a.Flatten()
+			So(f.Shape, ShouldResemble, []int{6})
+			So(f.MustAt(4), ShouldEqual, 4)
+		})
+
+		Convey("Unsqueeze, ExpandDims and Squeeze round-trip", func() {
+			u, err := a.Unsqueeze(1)
+			So(err, ShouldBeNil)
+			So(u.Shape, ShouldResemble, []int{2, 1, 3})
+			So(u.MustAt(1, 0, 2), ShouldEqual, 5)
+
+			e, err := a.ExpandDims(0, 3)
+			So(err, ShouldBeNil)
+			So(e.Shape, ShouldResemble, []int{1, 2, 3, 1})
+
+			s, err := e.Squeeze()
+			So(err, ShouldBeNil)
+			So(s.Shape, ShouldResemble, []int{2, 3})
+			So(Equal(s, a), ShouldBeTrue)
+
+			s, err = e.Squeeze(3)
+			So(err, ShouldBeNil)
+			So(s.Shape, ShouldResemble, []int{1, 2, 3})
+
+			_, err = e.Squeeze(1)
+			So(errors.Is(err, ErrInvalidAxis), ShouldBeTrue)
+			_, err = a.ExpandDims(0, 0)
+			So(errors.Is(err, ErrDuplicateAxis), ShouldBeTrue)
+			_, err = a.Unsqueeze(3)
+			So(errors.Is(err, ErrInvalidAxis), ShouldBeTrue)
+		})
+	})
+}
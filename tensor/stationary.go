@@ -0,0 +1,240 @@
+package tensor
+
+import (
+	"fmt"
+	"math"
+)
+
+// StationaryOptions configures SolveJacobi, SolveGaussSeidel and SolveSOR.
+// A nil *StationaryOptions or zero fields select the defaults.
+type StationaryOptions[T Number] struct {
+	Tol     float64    // relative residual ‖b - A·x‖/‖b‖, default √eps
+	MaxIter int        // default 1000
+	X0      *Vector[T] // initial guess, default zero
+	// Callback is called after every sweep with the current iterate and its
+	// relative residual. Returning false stops the iteration early.
+	Callback func(iter int, x *Vector[T], residual float64) bool
+}
+
+// Diagnostics tells whether a stationary iteration is known to converge.
+type Diagnostics struct {
+	DiagonallyDominant bool    // strictly, by rows
+	HermitianPD        bool    // Hermitian positive definite; tested only if Guaranteed depends on it
+	SpectralRadius     float64 // power-iteration estimate of ρ of the iteration matrix
+	Guaranteed         bool
+	Warning            string // empty when Guaranteed
+}
+
+// StationaryResult is an IterResult with the convergence diagnostics.
+type StationaryResult[T Number] struct {
+	IterResult[T]
+	Diagnostics Diagnostics
+}
+
+// SolveJacobi solves A·x = b by Jacobi iteration. It converges for strictly
+// diagonally dominant A.
+func SolveJacobi[T Number](a *Matrix[T], b *Vector[T], opts *StationaryOptions[T]) (*StationaryResult[T], error) {
+	return solveStationary("SolveJacobi", a, b, 1, true, opts)
+}
+
+// SolveGaussSeidel solves A·x = b by Gauss–Seidel iteration. It converges for
+// strictly diagonally dominant or Hermitian positive definite A.
+func SolveGaussSeidel[T Number](a *Matrix[T], b *Vector[T], opts *StationaryOptions[T]) (*StationaryResult[T], error) {
+	return solveStationary("SolveGaussSeidel", a, b, 1, false, opts)
+}
+
+// SolveSOR solves A·x = b by successive over-relaxation. For Hermitian
+// positive definite A it converges for every omega in (0, 2); outside that
+// interval it never converges and ErrInvalidRelaxation is returned.
+func SolveSOR[T Number](a *Matrix[T], b *Vector[T], omega float64, opts *StationaryOptions[T]) (*StationaryResult[T], error) {
+	return solveStationary("SolveSOR", a, b, omega, false, opts)
+}
+
+// solveStationary runs the sweeps. A non-converged result is returned with
+// ErrNoConvergence, unless the callback stopped the iteration.
+func solveStationary[T Number](op string, a *Matrix[T], b *Vector[T], omega float64, jacobi bool, opts *StationaryOptions[T]) (res *StationaryResult[T], err error) {
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if isInteger[T]() {
+		return nil, ErrNotImplemented
+	}
+	if !(omega > 0 && omega < 2) {
+		return nil, ErrInvalidRelaxation
+	}
+	if opts == nil {
+		opts = &StationaryOptions[T]{}
+	}
+	n := a.Shape[0]
+	if a.Shape[1] != n {
+		return nil, ErrNotSquare
+	}
+	if len(b.Shape) != 1 || b.Shape[0] != n {
+		return nil, ErrShapeMismatch
+	}
+	for i := 0; i < n; i++ {
+		if a.MustAt(i, i) == 0 {
+			return nil, ErrSingularMatrix
+		}
+	}
+
+	s := &stationary[T]{a: a.Copy().Data, n: n, omega: fromFloat[T](omega), jacobi: jacobi}
+	res = &StationaryResult[T]{Diagnostics: s.diagnose(a, omega)}
+
+	tol, maxIter := opts.Tol, opts.MaxIter
+	if tol <= 0 {
+		tol = math.Sqrt(machineEpsilon[T]())
+	}
+	if maxIter <= 0 {
+		maxIter = 1000
+	}
+	bs := b.Contiguous().contiguousData()
+	bnorm := norm2(bs)
+	if bnorm == 0 {
+		bnorm = 1
+	}
+	x := NewVector[T](n)
+	if opts.X0 != nil {
+		if len(opts.X0.Shape) != 1 || opts.X0.Shape[0] != n {
+			return nil, ErrShapeMismatch
+		}
+		copy(x.Data, opts.X0.Contiguous().contiguousData())
+	}
+	res.X = x
+
+	record := func() bool {
+		res.Residual = s.residual(x.Data, bs) / bnorm
+		res.History = append(res.History, res.Residual)
+		return res.Residual <= tol
+	}
+	if record() {
+		res.Converged = true
+		return res, nil
+	}
+	for res.Iterations < maxIter {
+		s.sweep(x.Data, bs)
+		res.Iterations++
+		res.Converged = record()
+		if opts.Callback != nil && !opts.Callback(res.Iterations, x, res.Residual) {
+			return res, nil
+		}
+		if res.Converged {
+			return res, nil
+		}
+		if math.IsNaN(res.Residual) || math.IsInf(res.Residual, 0) {
+			break
+		}
+	}
+	return res, ErrNoConvergence
+}
+
+type stationary[T Number] struct {
+	a      []T // row-major n x n
+	n      int
+	omega  T
+	jacobi bool
+}
+
+// sweep performs one iteration x ← G·x + c in place.
+func (s *stationary[T]) sweep(x, b []T) {
+	n := s.n
+	old := x
+	if s.jacobi {
+		old = append([]T(nil), x...)
+	}
+	for i := 0; i < n; i++ {
+		row := s.a[i*n : (i+1)*n]
+		sum := b[i]
+		for j, v := range row {
+			if j != i {
+				sum -= v * old[j]
+			}
+		}
+		x[i] += s.omega * (sum/row[i] - x[i])
+	}
+}
+
+func (s *stationary[T]) residual(x, b []T) float64 {
+	r := make([]T, s.n)
+	for i := range r {
+		sum := b[i]
+		for j, v := range s.a[i*s.n : (i+1)*s.n] {
+			sum -= v * x[j]
+		}
+		r[i] = sum
+	}
+	return norm2(r)
+}
+
+// spectralRadius estimates ρ(G) by power iteration on the homogeneous sweep,
+// taking the geometric mean growth over the second half of the run.
+func (s *stationary[T]) spectralRadius() float64 {
+	const steps = 64
+	x := make([]T, s.n)
+	for i := range x {
+		x[i] = fromFloat[T](1 + float64(i%3)/7)
+	}
+	zero := make([]T, s.n)
+	logGrowth := 0.0
+	for k := 0; k < steps; k++ {
+		s.sweep(x, zero)
+		norm := norm2(x)
+		if norm == 0 {
+			return 0
+		}
+		if k >= steps/2 {
+			logGrowth += math.Log(norm)
+		}
+		f := fromFloat[T](1 / norm)
+		for i := range x {
+			x[i] *= f
+		}
+	}
+	return math.Exp(logGrowth / (steps - steps/2))
+}
+
+func (s *stationary[T]) diagnose(a *Matrix[T], omega float64) Diagnostics {
+	n := s.n
+	d := Diagnostics{DiagonallyDominant: true, SpectralRadius: s.spectralRadius()}
+	hermitian := true
+	for i := 0; i < n; i++ {
+		off := 0.0
+		for j := 0; j < n; j++ {
+			if j != i {
+				off += modulus(s.a[i*n+j])
+			}
+			if s.a[i*n+j] != conj(s.a[j*n+i]) {
+				hermitian = false
+			}
+		}
+		if off >= modulus(s.a[i*n+i]) {
+			d.DiagonallyDominant = false
+		}
+	}
+	// Холецкий стоит O(n³), поэтому только когда он что-то решает
+	hermitianPD := func() bool {
+		if hermitian {
+			_, err := Cholesky(a)
+			d.HermitianPD = err == nil
+		}
+		return d.HermitianPD
+	}
+
+	switch {
+	case s.jacobi:
+		d.Guaranteed = d.DiagonallyDominant
+	case omega == 1:
+		d.Guaranteed = d.DiagonallyDominant || hermitianPD()
+	default:
+		d.Guaranteed = (d.DiagonallyDominant && omega < 1) || hermitianPD()
+	}
+	switch {
+	case d.Guaranteed:
+	case d.SpectralRadius >= 1:
+		d.Warning = fmt.Sprintf("spectral radius estimate %.3g >= 1: the iteration will likely diverge", d.SpectralRadius)
+	default:
+		d.Warning = fmt.Sprintf("convergence is not guaranteed for this matrix (spectral radius estimate %.3g)", d.SpectralRadius)
+	}
+	return d
+}
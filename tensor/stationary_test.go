@@ -0,0 +1,99 @@
+package tensor
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStationary(t *testing.T) {
+	Convey("Given a diagonally dominant system", t, func() {
+		a := NewMatrix[float64](3, 3)
+		a.Data = []float64{10, -1, 2, -1, 11, -1, 2, -1, 10}
+		b := NewVector[float64](3)
+		b.Data = []float64{6, 25, -11}
+		want, err := SolveGauss(a, b)
+		So(err, ShouldBeNil)
+
+		Convey("All three methods converge to the direct solution", func() {
+			opts := &StationaryOptions[float64]{Tol: 1e-12}
+			jac, err := SolveJacobi(a, b, opts)
+			So(err, ShouldBeNil)
+			gs, err := SolveGaussSeidel(a, b, opts)
+			So(err, ShouldBeNil)
+			sor, err := SolveSOR(a, b, 1.05, opts)
+			So(err, ShouldBeNil)
+
+			for _, res := range []*StationaryResult[float64]{jac, gs, sor} {
+				So(res.Converged, ShouldBeTrue)
+				So(res.Diagnostics.Guaranteed, ShouldBeTrue)
+				So(res.Diagnostics.Warning, ShouldBeEmpty)
+				So(res.Diagnostics.SpectralRadius, ShouldBeLessThan, 1)
+				expectClose(t, res.X.Data, want.Data, 1e-10)
+			}
+			So(gs.Iterations, ShouldBeLessThan, jac.Iterations)
+		})
+
+		Convey("The callback sees every iterate and can stop early", func() {
+			var seen []float64
+			res, err := SolveJacobi(a, b, &StationaryOptions[float64]{
+				Callback: func(iter int, x *Vector[float64], residual float64) bool {
+					seen = append(seen, residual)
+					return iter < 3
+				},
+			})
+			So(err, ShouldBeNil)
+			So(res.Converged, ShouldBeFalse)
+			So(res.Iterations, ShouldEqual, 3)
+			So(seen, ShouldResemble, res.History[1:])
+
+			seen = nil
+			res, err = SolveGaussSeidel(a, b, &StationaryOptions[float64]{
+				Callback: func(iter int, x *Vector[float64], residual float64) bool {
+					seen = append(seen, residual)
+					return true
+				},
+			})
+			So(err, ShouldBeNil)
+			So(res.Converged, ShouldBeTrue)
+			So(seen, ShouldResemble, res.History[1:])
+		})
+	})
+
+	Convey("Given a matrix the Jacobi iteration diverges on", t, func() {
+		a := NewMatrix[float64](2, 2)
+		a.Data = []float64{1, 2, 3, 1}
+		b := NewVector[float64](2)
+		b.Data = []float64{1, 1}
+
+		res, err := SolveJacobi(a, b, &StationaryOptions[float64]{MaxIter: 50})
+		So(errors.Is(err, ErrNoConvergence), ShouldBeTrue)
+		So(res.Diagnostics.Guaranteed, ShouldBeFalse)
+		So(res.Diagnostics.SpectralRadius, ShouldAlmostEqual, 2.449, 1e-3)
+		So(res.Diagnostics.Warning, ShouldContainSubstring, "diverge")
+	})
+
+	Convey("A symmetric positive definite matrix is accepted by Gauss–Seidel", t, func() {
+		a := NewMatrix[float64](2, 2)
+		a.Data = []float64{1, 2, 2, 5}
+		b := NewVector[float64](2)
+		b.Data = []float64{1, 2}
+		res, err := SolveSOR(a, b, 1.5, nil)
+		So(err, ShouldBeNil)
+		So(res.Diagnostics.DiagonallyDominant, ShouldBeFalse)
+		So(res.Diagnostics.HermitianPD, ShouldBeTrue)
+		So(res.Diagnostics.Guaranteed, ShouldBeTrue)
+		expectClose(t, res.X.Data, []float64{1, 0}, 1e-7)
+	})
+
+	Convey("Invalid input is rejected", t, func() {
+		a := Identity[float64](2)
+		b := NewVector[float64](2)
+		_, err := SolveSOR(a, b, 2, nil)
+		So(errors.Is(err, ErrInvalidRelaxation), ShouldBeTrue)
+		a.MustSet(0, 1, 1)
+		_, err = SolveGaussSeidel(a, b, nil)
+		So(errors.Is(err, ErrSingularMatrix), ShouldBeTrue)
+	})
+}
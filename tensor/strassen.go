@@ -0,0 +1,217 @@
+package tensor
+
+// MatMulOptions tunes MatMulWith. The zero value gives the same result as
+// MatMul.
+type MatMulOptions struct {
+	// Workers is the number of goroutines of the blocked kernel; 0 uses the
+	// value set by SetMatMulWorkers.
+	Workers int
+
+	// Strassen enables the Strassen–Winograd recursion for square products
+	// larger than StrassenCrossover. It trades accuracy for speed, see
+	// MatMulWith.
+	Strassen bool
+
+	// StrassenCrossover is the size at which the recursion hands over to the
+	// blocked kernel, 128 by default.
+	StrassenCrossover int
+}
+
+const defaultStrassenCrossover = 128
+
+// MatMulWith returns a·b like MatMul, with the algorithm chosen by opts.
+//
+// The Strassen–Winograd path needs 7 instead of 8 half-size products per
+// level, so its cost is O(n^2.81). Odd sizes are handled by padding the
+// operands once with zero rows and columns to c·2^k, where c is at most the
+// crossover. Its error bound is only normwise: with unit round-off u and
+// n0 the crossover,
+//
+//	‖C - Ĉ‖ ≤ ((n/n0)^log₂18·(n0² + 6·n0) - 6·n)·u·‖A‖·‖B‖ + O(u²),
+//
+// compared with the componentwise |C - Ĉ| ≤ n·u·|A|·|B| of the conventional
+// product. Entries of C much smaller than ‖A‖·‖B‖ may therefore lose all
+// relative accuracy; matrices of widely varying scale should not use it.
+// For integer types the result is exact unless an intermediate sum
+// overflows, which can happen even when the conventional product does not.
+func MatMulWith[T Number](a, b *Matrix[T], opts MatMulOptions) (out *Matrix[T], err error) {
+	if a.Shape[1] != b.Shape[0] {
+		return nil, ErrShapeMismatch
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = matMulWorkerCount()
+	}
+	crossover := opts.StrassenCrossover
+	if crossover <= 0 {
+		crossover = defaultStrassenCrossover
+	}
+
+	m, k, n := a.Shape[0], a.Shape[1], b.Shape[1]
+	out = NewMatrix[T](m, n)
+	switch {
+	case opts.Strassen && m == k && k == n && n > crossover:
+		strassenPadded(out, a, b, crossover, workers)
+	default:
+		matMulInto(out, a, b, workers)
+	}
+	return out, nil
+}
+
+// strassenPadded pads the n x n operands to c·2^k with c <= crossover, so that
+// every level of the recursion splits evenly.
+func strassenPadded[T Number](out, a, b *Matrix[T], crossover, workers int) {
+	n := out.Shape[0]
+	size, levels := n, 0
+	for size > crossover {
+		size = (size + 1) / 2
+		levels++
+	}
+	padded := size << levels
+	work, buf := newStrassenWork[T](padded, levels)
+	defer putScratch(buf)
+	if padded == n {
+		strassen(out, a, b, work, workers)
+		return
+	}
+
+	pad := func(m *Matrix[T]) *Matrix[T] {
+		p := NewMatrix[T](padded, padded)
+		copyInto(p.MustSubMatrix(0, n, 0, n).Tensor, m.Tensor)
+		return p
+	}
+	c := NewMatrix[T](padded, padded)
+	strassen(c, pad(a), pad(b), work, workers)
+	copyInto(out.Tensor, c.MustSubMatrix(0, n, 0, n).Tensor)
+}
+
+// strassenLevel is the scratch of one level of the recursion: the sums s1..s4
+// of A, t1..t4 of B and the seven products, all of the half size. The levels
+// below reuse theirs for each of the seven products.
+type strassenLevel[T Number] struct {
+	s, t [4]*Matrix[T]
+	p    [7]*Matrix[T]
+}
+
+// newStrassenWork lays out the scratch of every level in one pooled buffer of
+// about 5·n² elements, which the caller returns with putScratch.
+func newStrassenWork[T Number](n, levels int) ([]strassenLevel[T], *[]T) {
+	total := 0
+	for h, l := n/2, 0; l < levels; h, l = h/2, l+1 {
+		total += 15 * h * h
+	}
+	buf := getScratch[T](total)
+	work := make([]strassenLevel[T], levels)
+	off := 0
+	block := func(h int) *Matrix[T] {
+		m := &Matrix[T]{&Tensor[T]{
+			Shape:   []int{h, h},
+			size:    h * h,
+			Strides: []int{h, 1},
+			Offset:  off,
+			Data:    *buf,
+		}}
+		off += h * h
+		return m
+	}
+	for l := range work {
+		n /= 2
+		w := &work[l]
+		for i := range w.s {
+			w.s[i], w.t[i] = block(n), block(n)
+		}
+		for i := range w.p {
+			w.p[i] = block(n)
+		}
+	}
+	return work, buf
+}
+
+// strassen stores a·b in c by the Winograd form of Strassen's recursion: 7
+// products and 15 additions per level, one level per entry of work. Below
+// the last level the blocked kernel takes over.
+func strassen[T Number](c, a, b *Matrix[T], work []strassenLevel[T], workers int) {
+	n := c.Shape[0]
+	if len(work) == 0 {
+		// gemm накапливает, а буферы уровней переиспользуются
+		for i := 0; i < n; i++ {
+			clear(c.Data[c.Offset+i*c.Strides[0]:][:n])
+		}
+		gemm(c, a, b, workers)
+		return
+	}
+	h := n / 2
+	quad := func(m *Matrix[T]) (q11, q12, q21, q22 *Matrix[T]) {
+		return m.MustSubMatrix(0, h, 0, h), m.MustSubMatrix(0, h, h, n),
+			m.MustSubMatrix(h, n, 0, h), m.MustSubMatrix(h, n, h, n)
+	}
+	add := func(dst, x, y *Matrix[T]) *Matrix[T] {
+		return combine(dst, x, y, false)
+	}
+	sub := func(dst, x, y *Matrix[T]) *Matrix[T] {
+		return combine(dst, x, y, true)
+	}
+	w := &work[0]
+	mul := func(k int, x, y *Matrix[T]) *Matrix[T] {
+		strassen(w.p[k], x, y, work[1:], workers)
+		return w.p[k]
+	}
+
+	a11, a12, a21, a22 := quad(a)
+	b11, b12, b21, b22 := quad(b)
+	c11, c12, c21, c22 := quad(c)
+
+	s1 := add(w.s[0], a21, a22)
+	s2 := sub(w.s[1], s1, a11)
+	s3 := sub(w.s[2], a11, a21)
+	s4 := sub(w.s[3], a12, s2)
+	t1 := sub(w.t[0], b12, b11)
+	t2 := sub(w.t[1], b22, t1)
+	t3 := sub(w.t[2], b22, b12)
+	t4 := sub(w.t[3], t2, b21)
+
+	p1 := mul(0, a11, b11)
+	p2 := mul(1, a12, b21)
+	p3 := mul(2, s4, b22)
+	p4 := mul(3, a22, t4)
+	p5 := mul(4, s1, t1)
+	p6 := mul(5, s2, t2)
+	p7 := mul(6, s3, t3)
+
+	add(c11, p1, p2)
+	u2 := add(p6, p1, p6)
+	u3 := add(p7, u2, p7)
+	u4 := add(u2, u2, p5)
+	add(c12, u4, p3)
+	sub(c21, u3, p4)
+	add(c22, u3, p5)
+}
+
+// combine stores x + y, or x - y when minus is set, in dst. The operands are
+// quadrants of row-major matrices, so whole rows are contiguous.
+func combine[T Number](dst, x, y *Matrix[T], minus bool) *Matrix[T] {
+	n := dst.Shape[1]
+	if dst.Strides[1] != 1 || x.Strides[1] != 1 || y.Strides[1] != 1 {
+		if minus {
+			zipInto(dst.Tensor, x.Tensor, y.Tensor, func(u, v T) T { return u - v })
+		} else {
+			zipInto(dst.Tensor, x.Tensor, y.Tensor, func(u, v T) T { return u + v })
+		}
+		return dst
+	}
+	for i := 0; i < dst.Shape[0]; i++ {
+		d := dst.Data[dst.Offset+i*dst.Strides[0]:][:n]
+		xs := x.Data[x.Offset+i*x.Strides[0]:][:n]
+		ys := y.Data[y.Offset+i*y.Strides[0]:][:n]
+		if minus {
+			for j := range d {
+				d[j] = xs[j] - ys[j]
+			}
+		} else {
+			for j := range d {
+				d[j] = xs[j] + ys[j]
+			}
+		}
+	}
+	return dst
+}
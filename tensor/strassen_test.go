@@ -0,0 +1,54 @@
+package tensor
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStrassen(t *testing.T) {
+	Convey("Strassen–Winograd is exact on integers", t, func() {
+		for _, n := range []int{8, 9, 33, 50} {
+			a := NewMatrix[int](n, n)
+			RandomTensorN(a.Tensor, 20)
+			b := NewMatrix[int](n, n)
+			RandomTensorN(b.Tensor, 20)
+
+			got, err := MatMulWith(a, b, MatMulOptions{Strassen: true, StrassenCrossover: 4})
+			So(err, ShouldBeNil)
+			So(got.Data, ShouldResemble, naiveProduct(a, b))
+		}
+	})
+
+	Convey("Floating point results stay within the normwise bound", t, func() {
+		const n = 100
+		a := NewMatrix[float64](n, n)
+		RandomTensor(a.Tensor)
+		b := NewMatrix[float64](n, n)
+		RandomTensor(b.Tensor)
+
+		got, err := MatMulWith(a, b, MatMulOptions{Strassen: true, StrassenCrossover: 16, Workers: 2})
+		So(err, ShouldBeNil)
+		want := naiveProduct(a, b)
+		diff := make([]float64, len(want))
+		for i := range diff {
+			diff[i] = got.Data[i] - want[i]
+		}
+		So(norm2(diff), ShouldBeLessThan, 1e3*machineEpsilon[float64]()*norm2(a.Data)*norm2(b.Data))
+		So(math.IsNaN(norm2(diff)), ShouldBeFalse)
+	})
+
+	Convey("Non-square and small products ignore the Strassen option", t, func() {
+		a := NewMatrix[int](3, 5)
+		RandomTensorN(a.Tensor, 9)
+		b := NewMatrix[int](5, 2)
+		RandomTensorN(b.Tensor, 9)
+		got, err := MatMulWith(a, b, MatMulOptions{Strassen: true})
+		So(err, ShouldBeNil)
+		So(got.Data, ShouldResemble, naiveProduct(a, b))
+
+		_, err = MatMulWith(a, a, MatMulOptions{})
+		So(err, ShouldEqual, ErrShapeMismatch)
+	})
+}
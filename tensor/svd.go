@@ -0,0 +1,327 @@
+package tensor
+
+import (
+	"math"
+	"slices"
+	"sort"
+)
+
+const maxJacobiSweeps = 60
+
+// SVD is the thin singular value decomposition A = U·Σ·Vᴴ of an m x n matrix,
+// with k = min(m, n) singular values in descending order.
+type SVD[T Number] struct {
+	m, n int
+	u    *Matrix[T] // m x k
+	s    []float64  // k
+	v    *Matrix[T] // n x n, full so that the null space is available
+}
+
+// NewSVD computes the decomposition with one-sided (Hestenes) Jacobi
+// rotations. Only float and complex types are supported.
+func NewSVD[T Number](a *Matrix[T]) (out *SVD[T], err error) {
+	const op = "SVD"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if isInteger[T]() {
+		return nil, ErrNotImplemented
+	}
+
+	m, n := a.Shape[0], a.Shape[1]
+	rows := max(m, n)
+	// Для m < n дополняем A нулевыми строками: сингулярные числа и
+	// нуль-пространство не меняются, зато V получается полной
+	w := NewMatrix[T](rows, n)
+	top, err := w.SubMatrix(0, m, 0, n)
+	if err != nil {
+		return nil, err
+	}
+	copyInto(top.Tensor, a.Tensor)
+	v := Identity[T](n)
+
+	if err := jacobiOrthogonalize(w, v); err != nil {
+		return nil, err
+	}
+
+	norms := make([]float64, n)
+	order := make([]int, n)
+	for j := range order {
+		order[j] = j
+		norms[j] = columnNorm(w, j)
+	}
+	sort.SliceStable(order, func(i, j int) bool { return norms[order[i]] > norms[order[j]] })
+
+	k := min(m, n)
+	f := &SVD[T]{
+		m: m,
+		n: n,
+		u: NewMatrix[T](m, k),
+		s: make([]float64, k),
+		v: NewMatrix[T](n, n),
+	}
+	for j, src := range order {
+		for i := 0; i < n; i++ {
+			f.v.Data[i*n+j] = v.Data[i*n+src]
+		}
+	}
+
+	tol := 0.0
+	if n > 0 {
+		tol = f.tol(norms[order[0]])
+	}
+	var missing []int
+	for j := 0; j < k; j++ {
+		src := order[j]
+		f.s[j] = norms[src]
+		if f.s[j] <= tol {
+			missing = append(missing, j)
+			continue
+		}
+		scale := fromFloat[T](f.s[j])
+		for i := 0; i < m; i++ {
+			f.u.Data[i*k+j] = w.Data[i*n+src] / scale
+		}
+	}
+	completeBasis(f.u, missing)
+	return f, nil
+}
+
+// jacobiOrthogonalize rotates pairs of columns of w until they are mutually
+// orthogonal, accumulating the rotations in v.
+func jacobiOrthogonalize[T Number](w, v *Matrix[T]) error {
+	rows, n := w.Shape[0], w.Shape[1]
+	eps := machineEpsilon[T]() * float64(rows)
+	// Столбцы, ставшие пренебрежимо малыми относительно ‖A‖, больше не вращаем:
+	// их направление определяется шумом округления и сходимости не будет
+	frob := 0.0
+	for j := 0; j < n; j++ {
+		frob = math.Hypot(frob, columnNorm(w, j))
+	}
+	tiny := (eps * frob) * (eps * frob)
+	for sweep := 0; sweep < maxJacobiSweeps; sweep++ {
+		rotated := false
+		for i := 0; i < n-1; i++ {
+			for j := i + 1; j < n; j++ {
+				var alpha, beta float64
+				var gamma T
+				for r := 0; r < rows; r++ {
+					x, y := w.Data[r*n+i], w.Data[r*n+j]
+					alpha += modulus(x) * modulus(x)
+					beta += modulus(y) * modulus(y)
+					gamma += conj(x) * y
+				}
+				g := modulus(gamma)
+				if g == 0 || g <= eps*math.Sqrt(alpha*beta) || min(alpha, beta) <= tiny {
+					continue
+				}
+				rotated = true
+
+				zeta := (beta - alpha) / (2 * g)
+				t := math.Copysign(1, zeta) / (math.Abs(zeta) + math.Sqrt(1+zeta*zeta))
+				c := 1 / math.Sqrt(1+t*t)
+				ct, st := fromFloat[T](c), fromFloat[T](c*t)
+				e := conj(phase(gamma))
+				rotateColumns(w, i, j, ct, st, e)
+				rotateColumns(v, i, j, ct, st, e)
+			}
+		}
+		if !rotated {
+			return nil
+		}
+	}
+	return ErrNoConvergence
+}
+
+// rotateColumns sets (x, y) = (c·x - s·e·y, s·x + c·e·y) for columns i and j.
+func rotateColumns[T Number](m *Matrix[T], i, j int, c, s, e T) {
+	cols := m.Shape[1]
+	for r := 0; r < m.Shape[0]; r++ {
+		x, y := m.Data[r*cols+i], e*m.Data[r*cols+j]
+		m.Data[r*cols+i] = c*x - s*y
+		m.Data[r*cols+j] = s*x + c*y
+	}
+}
+
+func columnNorm[T Number](m *Matrix[T], j int) float64 {
+	col := make([]T, m.Shape[0])
+	for i := range col {
+		col[i] = m.Data[i*m.Shape[1]+j]
+	}
+	return norm2(col)
+}
+
+// completeBasis fills the listed columns of the contiguous matrix u with unit
+// vectors orthogonal to all other columns, by Gram-Schmidt on the standard basis.
+func completeBasis[T Number](u *Matrix[T], missing []int) {
+	rows, cols := u.Shape[0], u.Shape[1]
+	filled := make([]bool, cols)
+	for j := range filled {
+		filled[j] = !slices.Contains(missing, j)
+	}
+	col := make([]T, rows)
+	for _, j := range missing {
+		for e := 0; e < rows; e++ {
+			clear(col)
+			col[e] = 1
+			for pass := 0; pass < 2; pass++ {
+				for q := 0; q < cols; q++ {
+					if !filled[q] {
+						continue
+					}
+					var p T
+					for r := 0; r < rows; r++ {
+						p += conj(u.Data[r*cols+q]) * col[r]
+					}
+					for r := 0; r < rows; r++ {
+						col[r] -= p * u.Data[r*cols+q]
+					}
+				}
+			}
+			if nrm := norm2(col); nrm > 0.5 {
+				for r := 0; r < rows; r++ {
+					u.Data[r*cols+j] = col[r] / fromFloat[T](nrm)
+				}
+				filled[j] = true
+				break
+			}
+		}
+	}
+}
+
+// tol is the default threshold below which singular values count as zero.
+func (f *SVD[T]) tol(largest float64) float64 {
+	return largest * machineEpsilon[T]() * float64(max(f.m, f.n))
+}
+
+// U returns the m x k matrix of left singular vectors.
+func (f *SVD[T]) U() *Matrix[T] {
+	return &Matrix[T]{f.u.Copy()}
+}
+
+// S returns the singular values in descending order.
+func (f *SVD[T]) S() []float64 {
+	return slices.Clone(f.s)
+}
+
+// Sigma returns the singular values as a k x k diagonal matrix.
+func (f *SVD[T]) Sigma() *Matrix[T] {
+	k := len(f.s)
+	out := NewMatrix[T](k, k)
+	for i, s := range f.s {
+		out.Data[i*k+i] = fromFloat[T](s)
+	}
+	return out
+}
+
+// VH returns the k x n matrix of conjugated right singular vectors.
+func (f *SVD[T]) VH() *Matrix[T] {
+	k := len(f.s)
+	out := NewMatrix[T](k, f.n)
+	for i := 0; i < k; i++ {
+		for j := 0; j < f.n; j++ {
+			out.Data[i*f.n+j] = conj(f.v.Data[j*f.n+i])
+		}
+	}
+	return out
+}
+
+// Rank counts singular values above tol. A non-positive tol selects
+// max(m, n)·σ₁·ε.
+func (f *SVD[T]) Rank(tol float64) int {
+	if len(f.s) == 0 {
+		return 0
+	}
+	if tol <= 0 {
+		tol = f.tol(f.s[0])
+	}
+	r := 0
+	for _, s := range f.s {
+		if s > tol {
+			r++
+		}
+	}
+	return r
+}
+
+// ConditionNumber returns σ₁/σₖ in the 2-norm, +Inf for singular matrices.
+func (f *SVD[T]) ConditionNumber() float64 {
+	if len(f.s) == 0 {
+		return 0
+	}
+	last := f.s[len(f.s)-1]
+	if last == 0 {
+		return math.Inf(1)
+	}
+	return f.s[0] / last
+}
+
+// PseudoInverse returns the Moore-Penrose inverse V·Σ⁺·Uᴴ, treating singular
+// values not above tol as zero (see Rank for the default).
+func (f *SVD[T]) PseudoInverse(tol float64) *Matrix[T] {
+	r, k := f.Rank(tol), len(f.s)
+	out := NewMatrix[T](f.n, f.m)
+	for i := 0; i < f.n; i++ {
+		for j := 0; j < f.m; j++ {
+			var s T
+			for q := 0; q < r; q++ {
+				s += f.v.Data[i*f.n+q] * conj(f.u.Data[j*k+q]) / fromFloat[T](f.s[q])
+			}
+			out.Data[i*f.m+j] = s
+		}
+	}
+	return out
+}
+
+// NullSpace returns an orthonormal basis of {x : A·x = 0} as columns.
+func (f *SVD[T]) NullSpace(tol float64) *Matrix[T] {
+	r := f.Rank(tol)
+	out := NewMatrix[T](f.n, f.n-r)
+	for i := 0; i < f.n; i++ {
+		copy(out.Data[i*(f.n-r):(i+1)*(f.n-r)], f.v.Data[i*f.n+r:(i+1)*f.n])
+	}
+	return out
+}
+
+// RangeSpace returns an orthonormal basis of the column space of A as columns.
+func (f *SVD[T]) RangeSpace(tol float64) *Matrix[T] {
+	r, k := f.Rank(tol), len(f.s)
+	out := NewMatrix[T](f.m, r)
+	for i := 0; i < f.m; i++ {
+		copy(out.Data[i*r:(i+1)*r], f.u.Data[i*k:i*k+r])
+	}
+	return out
+}
+
+func PseudoInverse[T Number](m *Matrix[T], tol float64) (*Matrix[T], error) {
+	f, err := NewSVD(m)
+	if err != nil {
+		return nil, err
+	}
+	return f.PseudoInverse(tol), nil
+}
+
+func ConditionNumber[T Number](m *Matrix[T]) (float64, error) {
+	f, err := NewSVD(m)
+	if err != nil {
+		return 0, err
+	}
+	return f.ConditionNumber(), nil
+}
+
+func NullSpace[T Number](m *Matrix[T], tol float64) (*Matrix[T], error) {
+	f, err := NewSVD(m)
+	if err != nil {
+		return nil, err
+	}
+	return f.NullSpace(tol), nil
+}
+
+func RangeSpace[T Number](m *Matrix[T], tol float64) (*Matrix[T], error) {
+	f, err := NewSVD(m)
+	if err != nil {
+		return nil, err
+	}
+	return f.RangeSpace(tol), nil
+}
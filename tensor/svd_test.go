@@ -0,0 +1,109 @@
+package tensor
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func reconstructSVD[T Number](t *testing.T, f *SVD[T]) *Matrix[T] {
+	t.Helper()
+	us, err := MatMul(f.U(), f.Sigma())
+	So(err, ShouldBeNil)
+	out, err := MatMul(us, f.VH())
+	So(err, ShouldBeNil)
+	return out
+}
+
+func TestSVD(t *testing.T) {
+	Convey("Given a rank-deficient tall matrix", t, func() {
+		a := NewMatrix[float64](4, 3)
+		a.Data = []float64{
+			1, 2, 3,
+			2, 4, 6,
+			1, 0, 1,
+			0, 1, 1,
+		}
+		f, err := NewSVD(a)
+		So(err, ShouldBeNil)
+
+		Convey("U·Σ·Vᴴ reproduces A with sorted singular values", func() {
+			expectClose(t, reconstructSVD(t, f).Data, a.Data, 1e-12)
+			s := f.S()
+			So(s[0], ShouldBeGreaterThanOrEqualTo, s[1])
+			So(s[1], ShouldBeGreaterThanOrEqualTo, s[2])
+		})
+
+		Convey("U has orthonormal columns even for zero singular values", func() {
+			u := f.U()
+			utu, err := MatMul(ConjTranspose(u), u)
+			So(err, ShouldBeNil)
+			expectClose(t, utu.Data, Identity[float64](3).Data, 1e-12)
+		})
+
+		Convey("Rank, null space and range space", func() {
+			So(f.Rank(0), ShouldEqual, 2)
+			So(math.IsInf(f.ConditionNumber(), 1) || f.ConditionNumber() > 1e12, ShouldBeTrue)
+
+			ns := f.NullSpace(0)
+			So(ns.Shape, ShouldResemble, []int{3, 1})
+			an, err := MatMul(a, ns)
+			So(err, ShouldBeNil)
+			expectClose(t, an.Data, []float64{0, 0, 0, 0}, 1e-12)
+
+			So(f.RangeSpace(0).Shape, ShouldResemble, []int{4, 2})
+		})
+
+		Convey("PseudoInverse satisfies A·A⁺·A = A", func() {
+			pinv, err := PseudoInverse(a, 0)
+			So(err, ShouldBeNil)
+			So(pinv.Shape, ShouldResemble, []int{3, 4})
+			ap, err := MatMul(a, pinv)
+			So(err, ShouldBeNil)
+			apa, err := MatMul(ap, a)
+			So(err, ShouldBeNil)
+			expectClose(t, apa.Data, a.Data, 1e-10)
+		})
+	})
+
+	Convey("Wide matrices get a full null space", t, func() {
+		a := NewMatrix[float64](2, 4)
+		a.Data = []float64{1, 0, 2, 1, 0, 1, 1, 3}
+		f, err := NewSVD(a)
+		So(err, ShouldBeNil)
+		expectClose(t, reconstructSVD(t, f).Data, a.Data, 1e-12)
+
+		ns, err := NullSpace(a, 0)
+		So(err, ShouldBeNil)
+		So(ns.Shape, ShouldResemble, []int{4, 2})
+		an, err := MatMul(a, ns)
+		So(err, ShouldBeNil)
+		expectClose(t, an.Data, []float64{0, 0, 0, 0}, 1e-12)
+	})
+
+	Convey("Complex matrices and condition number", t, func() {
+		a := NewMatrix[complex128](2, 2)
+		a.Data = []complex128{1i, 0, 0, 2}
+		f, err := NewSVD(a)
+		So(err, ShouldBeNil)
+		expectClose(t, f.S(), []float64{2, 1}, 1e-12)
+		r := reconstructSVD(t, f)
+		for i := range a.Data {
+			So(cmplxDist(r.Data[i], a.Data[i]), ShouldBeLessThan, 1e-12)
+		}
+
+		c := NewMatrix[complex128](3, 3)
+		c.Data = []complex128{1 + 1i, 2, 0, 1i, 1 - 1i, 3, 2, 0, 1i}
+		g, err := NewSVD(c)
+		So(err, ShouldBeNil)
+		r = reconstructSVD(t, g)
+		for i := range c.Data {
+			So(cmplxDist(r.Data[i], c.Data[i]), ShouldBeLessThan, 1e-12)
+		}
+
+		cond, err := ConditionNumber(a)
+		So(err, ShouldBeNil)
+		So(cond, ShouldAlmostEqual, 2, 1e-12)
+	})
+}
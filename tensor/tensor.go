@@ -14,6 +14,7 @@ type Tensor[T Number] struct {
 	Shape   []int
 	size    int
 	Strides []int
+	Offset  int
 	Data    []T
 	zero    T
 }
@@ -24,29 +25,19 @@ func NewTensor[T Number](shape ...int) *Tensor[T] {
 		size *= d
 	}
 
-	strides := make([]int, len(shape))
-	stride := 1
-	for i := len(shape) - 1; i >= 0; i-- {
-		strides[i] = stride
-		stride *= shape[i]
-	}
-
 	return &Tensor[T]{
-		Shape:   shape,
+		Shape:   slices.Clone(shape),
 		size:    size,
-		Strides: strides,
+		Strides: rowMajorStrides(shape),
 		Data:    make([]T, size),
 	}
 }
 
+// Copy returns a contiguous tensor that owns its data, even if t is a view.
 func (t *Tensor[T]) Copy() *Tensor[T] {
-	return &Tensor[T]{
-		Shape:   slices.Clone(t.Shape),
-		size:    t.size,
-		Strides: slices.Clone(t.Strides),
-		Data:    slices.Clone(t.Data),
-		zero:    t.zero,
-	}
+	out := NewTensor[T](t.Shape...)
+	copyInto(out, t)
+	return out
 }
 
 func (t *Tensor[T]) SameShape(other *Tensor[T]) bool {
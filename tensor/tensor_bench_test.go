@@ -19,3 +19,104 @@ func BenchmarkNativeMul(b *testing.B) {
 		b.StopTimer()
 	}
 }
+
+func benchmarkMatMul[T Number](b *testing.B, n int) {
+	m1 := NewMatrix[T](n, n)
+	RandomTensor(m1.Tensor)
+	m2 := NewMatrix[T](n, n)
+	RandomTensor(m2.Tensor)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MatMul(m1, m2)
+	}
+}
+
+func BenchmarkMatMulFloat64_100(b *testing.B)    { benchmarkMatMul[float64](b, 100) }
+func BenchmarkMatMulFloat64_500(b *testing.B)    { benchmarkMatMul[float64](b, 500) }
+func BenchmarkMatMulFloat32_500(b *testing.B)    { benchmarkMatMul[float32](b, 500) }
+func BenchmarkMatMulComplex128_100(b *testing.B) { benchmarkMatMul[complex128](b, 100) }
+func BenchmarkMatMulComplex128_500(b *testing.B) { benchmarkMatMul[complex128](b, 500) }
+
+// mulInto against gemm on one goroutine around gemmThreshold (8·8·8).
+func benchmarkKernel[T Number](b *testing.B, n int, kernel func(out, m1, m2 *Matrix[T])) {
+	m1 := NewMatrix[T](n, n)
+	RandomTensor(m1.Tensor)
+	m2 := NewMatrix[T](n, n)
+	RandomTensor(m2.Tensor)
+	out := NewMatrix[T](n, n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		kernel(out, m1, m2)
+	}
+}
+
+func gemm1[T Number](out, m1, m2 *Matrix[T]) { gemm(out, m1, m2, 1) }
+
+func BenchmarkMulIntoFloat64_6(b *testing.B)    { benchmarkKernel(b, 6, mulInto[float64]) }
+func BenchmarkGemmFloat64_6(b *testing.B)       { benchmarkKernel(b, 6, gemm1[float64]) }
+func BenchmarkMulIntoFloat64_8(b *testing.B)    { benchmarkKernel(b, 8, mulInto[float64]) }
+func BenchmarkGemmFloat64_8(b *testing.B)       { benchmarkKernel(b, 8, gemm1[float64]) }
+func BenchmarkMulIntoComplex128_6(b *testing.B) { benchmarkKernel(b, 6, mulInto[complex128]) }
+func BenchmarkGemmComplex128_6(b *testing.B)    { benchmarkKernel(b, 6, gemm1[complex128]) }
+func BenchmarkMulIntoComplex128_8(b *testing.B) { benchmarkKernel(b, 8, mulInto[complex128]) }
+func BenchmarkGemmComplex128_8(b *testing.B)    { benchmarkKernel(b, 8, gemm1[complex128]) }
+
+// Strassen–Winograd on 512x512 against both the plain loop of nativeMul and
+// the blocked kernel behind MatMul. On one core a crossover of 128 is about
+// 25% faster than gemm at 512 and 30% at 1024; 64 gains as much at 1024 but
+// nothing more at 512, while 256 keeps only about half of the gain and a
+// crossover of n/2 (a single level) is no faster than gemm.
+func benchmark512(b *testing.B, mul func(m1, m2 *Matrix[float64])) {
+	m1 := NewMatrix[float64](512, 512)
+	RandomTensor(m1.Tensor)
+	m2 := NewMatrix[float64](512, 512)
+	RandomTensor(m2.Tensor)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mul(m1, m2)
+	}
+}
+
+func BenchmarkNativeMul512(b *testing.B) {
+	benchmark512(b, func(m1, m2 *Matrix[float64]) { nativeMul(m1, m2) })
+}
+
+func BenchmarkGemm512(b *testing.B) {
+	benchmark512(b, func(m1, m2 *Matrix[float64]) { MatMul(m1, m2) })
+}
+
+func BenchmarkStrassen512(b *testing.B) {
+	opts := MatMulOptions{Strassen: true, StrassenCrossover: 128}
+	benchmark512(b, func(m1, m2 *Matrix[float64]) { MatMulWith(m1, m2, opts) })
+}
+
+// 2·a + b∘c on 512x512: one fused loop against three eager passes with a
+// temporary each.
+func benchmarkExprOperands() (a, b, c *Tensor[float64]) {
+	a, b, c = NewTensor[float64](512, 512), NewTensor[float64](512, 512), NewTensor[float64](512, 512)
+	RandomTensor(a)
+	RandomTensor(b)
+	RandomTensor(c)
+	return a, b, c
+}
+
+func BenchmarkExprLazy512(b *testing.B) {
+	x, y, z := benchmarkExprOperands()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Lazy(x).Scale(2).Add(Lazy(y).ElemMul(Lazy(z))).MustEval()
+	}
+}
+
+func BenchmarkExprEager512(b *testing.B) {
+	x, y, z := benchmarkExprOperands()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p, _ := ElemMul(y, z)
+		Add(Scale(x, 2), p)
+	}
+}
@@ -0,0 +1,136 @@
+package tensor
+
+import (
+	"math"
+	"slices"
+)
+
+// Range selects Start, Start+Step, ... up to (but not including) Stop along one axis.
+// Negative Start and Stop count from the end of the axis, both are clamped to the
+// axis length. A zero Step means 1; negative steps are not supported.
+type Range struct {
+	Start, Stop, Step int
+}
+
+// All selects the whole axis.
+func All() Range {
+	return Range{Start: 0, Stop: math.MaxInt, Step: 1}
+}
+
+// Span selects [start, stop) with step 1.
+func Span(start, stop int) Range {
+	return Range{Start: start, Stop: stop, Step: 1}
+}
+
+func (r Range) normalize(dim int) (start, n, step int, err error) {
+	step = r.Step
+	if step == 0 {
+		step = 1
+	}
+	if step < 0 {
+		return 0, 0, 0, ErrInvalidRange
+	}
+	start, stop := clampIndex(r.Start, dim), clampIndex(r.Stop, dim)
+	if stop <= start {
+		return start, 0, step, nil
+	}
+	return start, (stop - start + step - 1) / step, step, nil
+}
+
+func clampIndex(i, dim int) int {
+	if i < 0 {
+		i += dim
+	}
+	return max(0, min(i, dim))
+}
+
+// View returns a tensor sharing t's data with its own Shape and Strides.
+func View[T Number](t *Tensor[T]) *Tensor[T] {
+	return &Tensor[T]{
+		Shape:   slices.Clone(t.Shape),
+		size:    t.size,
+		Strides: slices.Clone(t.Strides),
+		Offset:  t.Offset,
+		Data:    t.Data,
+	}
+}
+
+// Slice returns a view of t restricted to the given ranges. Axes without a range
+// are taken whole. The result shares t's data, so writes are visible in both.
+func Slice[T Number](t *Tensor[T], ranges ...Range) (out *Tensor[T], err error) {
+	const op = "Slice"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if len(ranges) > len(t.Shape) {
+		return nil, ErrWrongNumberOfIndices
+	}
+
+	out = View(t)
+	out.size = 1
+	for axis, dim := range t.Shape {
+		n := dim
+		if axis < len(ranges) {
+			start, cnt, step, err := ranges[axis].normalize(dim)
+			if err != nil {
+				return nil, err
+			}
+			n = cnt
+			if n > 0 {
+				out.Offset += start * t.Strides[axis]
+			}
+			out.Strides[axis] *= step
+		}
+		out.Shape[axis] = n
+		out.size *= n
+	}
+	return out, nil
+}
+
+// Select returns a view of t at position idx along axis, with that axis removed.
+func Select[T Number](t *Tensor[T], axis, idx int) (out *Tensor[T], err error) {
+	const op = "Select"
+	defer func() {
+		err = WrapIfNil(err, op)
+	}()
+
+	if axis < 0 || axis >= len(t.Shape) {
+		return nil, ErrInvalidAxis
+	}
+	if idx < 0 || idx >= t.Shape[axis] {
+		return nil, ErrIndexOutOfRange
+	}
+
+	return &Tensor[T]{
+		Shape:   slices.Delete(slices.Clone(t.Shape), axis, axis+1),
+		size:    t.size / t.Shape[axis],
+		Strides: slices.Delete(slices.Clone(t.Strides), axis, axis+1),
+		Offset:  t.Offset + idx*t.Strides[axis],
+		Data:    t.Data,
+	}, nil
+}
+
+func (t *Tensor[T]) View() *Tensor[T] {
+	return View(t)
+}
+
+func (t *Tensor[T]) Slice(ranges ...Range) (*Tensor[T], error) {
+	return Slice(t, ranges...)
+}
+
+func (t *Tensor[T]) MustSlice(ranges ...Range) *Tensor[T] {
+	out, err := Slice(t, ranges...)
+	Must(err)
+	return out
+}
+
+func (t *Tensor[T]) Select(axis, idx int) (*Tensor[T], error) {
+	return Select(t, axis, idx)
+}
+
+func (t *Tensor[T]) MustSelect(axis, idx int) *Tensor[T] {
+	out, err := Select(t, axis, idx)
+	Must(err)
+	return out
+}
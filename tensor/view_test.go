@@ -0,0 +1,93 @@
+package tensor
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTensorViews(t *testing.T) {
+	Convey("Given a 3x4 tensor", t, func() {
+		a := NewTensor[int](3, 4)
+		for i := range a.Data {
+			a.Data[i] = i
+		}
+
+		Convey("Slice shares data and honors offset and step", func() {
+			s, err := a.Slice(Span(1, 3), Range{Start: 0, Stop: 4, Step: 2})
+			So(err, ShouldBeNil)
+			So(s.Shape, ShouldResemble, []int{2, 2})
+			So(s.MustAt(0, 0), ShouldEqual, 4)
+			So(s.MustAt(0, 1), ShouldEqual, 6)
+			So(s.MustAt(1, 1), ShouldEqual, 10)
+
+			s.MustSet(100, 1, 0)
+			So(a.MustAt(2, 0), ShouldEqual, 100)
+		})
+
+		Convey("Missing ranges select whole axes and negative bounds count from the end", func() {
+			s := a.MustSlice(Span(-1, 3))
+			So(s.Shape, ShouldResemble, []int{1, 4})
+			So(s.MustAt(0, 3), ShouldEqual, 11)
+
+			s = a.MustSlice(All(), Span(1, -1))
+			So(s.Shape, ShouldResemble, []int{3, 2})
+			So(s.MustAt(2, 1), ShouldEqual, 10)
+		})
+
+		Convey("Empty and invalid ranges", func() {
+			s := a.MustSlice(Span(2, 1))
+			So(s.Shape, ShouldResemble, []int{0, 4})
+
+			_, err := a.Slice(Range{Start: 0, Stop: 3, Step: -1})
+			So(err, ShouldNotBeNil)
+
+			_, err = a.Slice(All(), All(), All())
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Select drops the axis", func() {
+			col := a.MustSelect(1, 2)
+			So(col.Shape, ShouldResemble, []int{3})
+			So(col.MustAt(0), ShouldEqual, 2)
+			So(col.MustAt(2), ShouldEqual, 10)
+
+			_, err := a.Select(2, 0)
+			So(err, ShouldNotBeNil)
+			_, err = a.Select(0, 3)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Copy of a view is contiguous and independent", func() {
+			s := a.MustSlice(Span(1, 3), Span(1, 3))
+			c := s.Copy()
+			So(c.Strides, ShouldResemble, []int{2, 1})
+			So(c.Offset, ShouldEqual, 0)
+			So(c.Data, ShouldResemble, []int{5, 6, 9, 10})
+
+			c.MustSet(-1, 0, 0)
+			So(a.MustAt(1, 1), ShouldEqual, 5)
+		})
+
+		Convey("Transpose is a view", func() {
+			tr := a.MustTranspose()
+			So(tr.MustAt(3, 1), ShouldEqual, 7)
+			tr.MustSet(42, 3, 1)
+			So(a.MustAt(1, 3), ShouldEqual, 42)
+		})
+
+		Convey("Matrix rows, columns and sub-matrices are views", func() {
+			m := NewMatrixFromTenzor(a)
+			So(m.MustRow(1).Copy().Data, ShouldResemble, []int{4, 5, 6, 7})
+			So(m.MustCol(3).Copy().Data, ShouldResemble, []int{3, 7, 11})
+
+			sub, err := m.SubMatrix(1, 3, 2, 4)
+			So(err, ShouldBeNil)
+			So(sub.PrettyString(), ShouldEqual, "6 7 \n10 11 \n")
+
+			So(sub.SwapRows(0, 1), ShouldBeNil)
+			So(a.MustAt(1, 2), ShouldEqual, 10)
+			So(a.MustAt(2, 3), ShouldEqual, 7)
+		})
+	})
+}